@@ -50,9 +50,11 @@ type ClientConfiguration struct {
 	Insecure   bool
 }
 
-// Key returns a string that is comparable to other ClientConfiguration values
+// Key returns a string that is comparable to other ClientConfiguration values.
+// Aliased provider configurations with a different token or hostname get a
+// different key, and therefore a different cached client.
 func (c ClientConfiguration) Key() string {
-	return fmt.Sprintf("%x %s/%v", sha256.New().Sum([]byte(c.Token)), c.TFEHost, c.Insecure)
+	return fmt.Sprintf("%x %s/%v", sha256.Sum256([]byte(c.Token)), c.TFEHost, c.Insecure)
 }
 
 // cliConfig tries to find and parse the configuration of the Terraform CLI.
@@ -191,7 +193,7 @@ func configure(tfeHost, token string, insecure bool) (*ClientConfiguration, erro
 
 	// Configure the certificate verification options.
 	if insecure {
-		log.Printf("[DEBUG] Warning: Client configured to skip certificate verifications")
+		log.Printf("[WARN] Client configured to skip certificate verifications, connections are vulnerable to man-in-the-middle attacks")
 	}
 
 	// Parse the hostname for comparison,
@@ -4,10 +4,60 @@
 package client
 
 import (
+	"encoding/hex"
 	"os"
+	"strings"
 	"testing"
+
+	svchost "github.com/hashicorp/terraform-svchost"
 )
 
+func TestClientConfiguration_Key(t *testing.T) {
+	base := ClientConfiguration{
+		TFEHost: svchost.Hostname("app.terraform.io"),
+		Token:   "token-one",
+	}
+
+	cases := map[string]struct {
+		config     ClientConfiguration
+		expectSame bool
+	}{
+		"same configuration": {
+			config:     base,
+			expectSame: true,
+		},
+		"different token": {
+			config: ClientConfiguration{
+				TFEHost: base.TFEHost,
+				Token:   "token-two",
+			},
+		},
+		"different hostname": {
+			config: ClientConfiguration{
+				TFEHost: svchost.Hostname("tfe.example.com"),
+				Token:   base.Token,
+			},
+		},
+		"different insecure": {
+			config: ClientConfiguration{
+				TFEHost:  base.TFEHost,
+				Token:    base.Token,
+				Insecure: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		if same := base.Key() == tc.config.Key(); same != tc.expectSame {
+			t.Fatalf("%s: expected keys to be the same: %v, got %v", name, tc.expectSame, same)
+		}
+
+		if strings.Contains(tc.config.Key(), hex.EncodeToString([]byte(tc.config.Token))) {
+			t.Fatalf("%s: key must not contain the encoded token", name)
+		}
+	}
+}
+
 func TestConfig_locateConfigFile(t *testing.T) {
 	originalHome := os.Getenv("HOME")
 	originalTfCliConfigFile := os.Getenv("TF_CLI_CONFIG_FILE")
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dataSourceTFEAdminSettingsCostEstimation{}
+	_ datasource.DataSourceWithConfigure = &dataSourceTFEAdminSettingsCostEstimation{}
+)
+
+// NewAdminSettingsCostEstimationDataSource is a helper function to simplify the provider implementation.
+func NewAdminSettingsCostEstimationDataSource() datasource.DataSource {
+	return &dataSourceTFEAdminSettingsCostEstimation{}
+}
+
+// dataSourceTFEAdminSettingsCostEstimation is the data source implementation.
+type dataSourceTFEAdminSettingsCostEstimation struct {
+	client *tfe.Client
+}
+
+// modelTFEAdminSettingsCostEstimation maps the data source schema data. The
+// cloud credentials are left out on purpose, only non-secret metadata is
+// exposed.
+type modelTFEAdminSettingsCostEstimation struct {
+	ID                        types.String `tfsdk:"id"`
+	Enabled                   types.Bool   `tfsdk:"enabled"`
+	AWSEnabled                types.Bool   `tfsdk:"aws_enabled"`
+	AWSAccessKeyID            types.String `tfsdk:"aws_access_key_id"`
+	AWSInstanceProfileEnabled types.Bool   `tfsdk:"aws_instance_profile_enabled"`
+	GCPEnabled                types.Bool   `tfsdk:"gcp_enabled"`
+	AzureEnabled              types.Bool   `tfsdk:"azure_enabled"`
+	AzureClientID             types.String `tfsdk:"azure_client_id"`
+	AzureSubscriptionID       types.String `tfsdk:"azure_subscription_id"`
+	AzureTenantID             types.String `tfsdk:"azure_tenant_id"`
+}
+
+// Metadata returns the data source type name.
+func (d *dataSourceTFEAdminSettingsCostEstimation) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_settings_cost_estimation"
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSourceTFEAdminSettingsCostEstimation) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source can be used to read the cost estimation settings of a Terraform Enterprise instance.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether cost estimation is enabled for all organizations.",
+				Computed:    true,
+			},
+			"aws_enabled": schema.BoolAttribute{
+				Description: "Whether AWS cost estimation is configured.",
+				Computed:    true,
+			},
+			"aws_access_key_id": schema.StringAttribute{
+				Description: "The ID of the AWS access key used to query AWS pricing.",
+				Computed:    true,
+			},
+			"aws_instance_profile_enabled": schema.BoolAttribute{
+				Description: "Whether the instance profile of the host is used to query AWS pricing.",
+				Computed:    true,
+			},
+			"gcp_enabled": schema.BoolAttribute{
+				Description: "Whether GCP cost estimation is configured.",
+				Computed:    true,
+			},
+			"azure_enabled": schema.BoolAttribute{
+				Description: "Whether Azure cost estimation is configured.",
+				Computed:    true,
+			},
+			"azure_client_id": schema.StringAttribute{
+				Description: "The client ID used to query Azure pricing.",
+				Computed:    true,
+			},
+			"azure_subscription_id": schema.StringAttribute{
+				Description: "The subscription ID used to query Azure pricing.",
+				Computed:    true,
+			},
+			"azure_tenant_id": schema.StringAttribute{
+				Description: "The tenant ID used to query Azure pricing.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dataSourceTFEAdminSettingsCostEstimation) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dataSourceTFEAdminSettingsCostEstimation) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	s, err := d.client.Admin.Settings.CostEstimation.Read(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read cost estimation settings", err.Error())
+		return
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &modelTFEAdminSettingsCostEstimation{
+		ID:                        types.StringValue(s.ID),
+		Enabled:                   types.BoolValue(s.Enabled),
+		AWSEnabled:                types.BoolValue(s.AWSEnabled),
+		AWSAccessKeyID:            types.StringValue(s.AWSAccessKeyID),
+		AWSInstanceProfileEnabled: types.BoolValue(s.AWSInstanceProfileEnabled),
+		GCPEnabled:                types.BoolValue(s.GCPEnabled),
+		AzureEnabled:              types.BoolValue(s.AzureEnabled),
+		AzureClientID:             types.StringValue(s.AzureClientID),
+		AzureSubscriptionID:       types.StringValue(s.AzureSubscriptionID),
+		AzureTenantID:             types.StringValue(s.AzureTenantID),
+	})
+	resp.Diagnostics.Append(diags...)
+}
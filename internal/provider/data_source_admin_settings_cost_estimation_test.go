@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Cost estimation settings are shared by the entire TFE instance, so this test
+// only checks that the attributes have SOME value.
+func TestAccTFEAdminSettingsCostEstimationDataSource_basic(t *testing.T) {
+	skipIfCloud(t)
+
+	resourceAddress := "data.tfe_admin_settings_cost_estimation.foobar"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEAdminSettingsCostEstimationDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceAddress, "id"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "enabled"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "aws_enabled"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "aws_instance_profile_enabled"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "gcp_enabled"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "azure_enabled"),
+				),
+			},
+		},
+	},
+	)
+}
+
+func testAccTFEAdminSettingsCostEstimationDataSourceConfig_basic() string {
+	return `data "tfe_admin_settings_cost_estimation" "foobar" {}`
+}
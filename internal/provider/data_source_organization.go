@@ -70,6 +70,21 @@ func dataSourceTFEOrganization() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+
+			"allow_force_delete_workspaces": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"session_timeout_minutes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"session_remember_minutes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -107,6 +122,9 @@ func dataSourceTFEOrganizationRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("two_factor_conformant", org.TwoFactorConformant)
 	d.Set("send_passing_statuses_for_untriggered_speculative_plans", org.SendPassingStatusesForUntriggeredSpeculativePlans)
 	d.Set("assessments_enforced", org.AssessmentsEnforced)
+	d.Set("allow_force_delete_workspaces", org.AllowForceDeleteWorkspaces)
+	d.Set("session_timeout_minutes", org.SessionTimeout)
+	d.Set("session_remember_minutes", org.SessionRemember)
 
 	return nil
 }
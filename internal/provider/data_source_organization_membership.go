@@ -41,6 +41,11 @@ func dataSourceTFEOrganizationMembership() *schema.Resource {
 				Computed: true,
 			},
 
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"organization_membership_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -32,6 +32,8 @@ func TestAccTFEOrganizationMembershipDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"data.tfe_organization_membership.foobar", "organization", orgName),
 					resource.TestCheckResourceAttrSet("data.tfe_organization_membership.foobar", "user_id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_organization_membership.foobar", "status", "invited"),
 				),
 			},
 			{
@@ -25,26 +25,31 @@ func dataSourceTFEOrganizationRunTask() *schema.Resource {
 			"organization": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 
 			"url": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 
 			"category": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 
 			"enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
+				Computed: true,
 			},
 
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -63,6 +68,7 @@ func dataSourceTFEOrganizationRunTaskRead(d *schema.ResourceData, meta interface
 		return err
 	}
 
+	d.Set("organization", organization)
 	d.Set("url", task.URL)
 	d.Set("category", task.Category)
 	d.Set("enabled", task.Enabled)
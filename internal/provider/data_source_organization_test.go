@@ -34,6 +34,9 @@ func TestAccTFEOrganizationDataSource_basic(t *testing.T) {
 					// check data attrs
 					resource.TestCheckResourceAttr("data.tfe_organization.foo", "name", orgName),
 					resource.TestCheckResourceAttr("data.tfe_organization.foo", "email", "admin@company.com"),
+					resource.TestCheckResourceAttrPair("data.tfe_organization.foo", "allow_force_delete_workspaces", "tfe_organization.foo", "allow_force_delete_workspaces"),
+					resource.TestCheckResourceAttrPair("data.tfe_organization.foo", "session_timeout_minutes", "tfe_organization.foo", "session_timeout_minutes"),
+					resource.TestCheckResourceAttrPair("data.tfe_organization.foo", "session_remember_minutes", "tfe_organization.foo", "session_remember_minutes"),
 				),
 			},
 		},
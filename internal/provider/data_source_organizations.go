@@ -87,7 +87,7 @@ func adminOrgsPopulateFields(client *tfe.Client) ([]string, map[string]string, e
 		}
 
 		// Exit the loop when we've seen all pages.
-		if orgList.CurrentPage >= orgList.TotalPages {
+		if orgList.Pagination == nil || orgList.CurrentPage >= orgList.TotalPages {
 			break
 		}
 
@@ -119,7 +119,7 @@ func orgsPopulateFields(client *tfe.Client) ([]string, map[string]string, error)
 		}
 
 		// Exit the loop when we've seen all pages.
-		if orgList.CurrentPage >= orgList.TotalPages {
+		if orgList.Pagination == nil || orgList.CurrentPage >= orgList.TotalPages {
 			break
 		}
 
@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &dataSourceTFERegistryModule{}
+	_ datasource.DataSourceWithConfigure      = &dataSourceTFERegistryModule{}
+	_ datasource.DataSourceWithValidateConfig = &dataSourceTFERegistryModule{}
+)
+
+// NewRegistryModuleDataSource is a helper function to simplify the provider implementation.
+func NewRegistryModuleDataSource() datasource.DataSource {
+	return &dataSourceTFERegistryModule{}
+}
+
+// dataSourceTFERegistryModule is the data source implementation.
+type dataSourceTFERegistryModule struct {
+	config ConfiguredClient
+}
+
+// modelTFERegistryModule maps the data source schema data.
+type modelTFERegistryModule struct {
+	ID                  types.String                    `tfsdk:"id"`
+	Organization        types.String                    `tfsdk:"organization"`
+	RegistryName        types.String                    `tfsdk:"registry_name"`
+	Namespace           types.String                    `tfsdk:"namespace"`
+	Name                types.String                    `tfsdk:"name"`
+	ModuleProvider      types.String                    `tfsdk:"module_provider"`
+	Status              types.String                    `tfsdk:"status"`
+	PublishingMechanism types.String                    `tfsdk:"publishing_mechanism"`
+	NoCode              types.Bool                      `tfsdk:"no_code"`
+	VCSRepo             []modelTFERegistryModuleVCSRepo `tfsdk:"vcs_repo"`
+}
+
+// modelTFERegistryModuleVCSRepo maps the VCS repository settings of a
+// registry module in the data source schema data.
+type modelTFERegistryModuleVCSRepo struct {
+	Identifier              types.String `tfsdk:"identifier"`
+	DisplayIdentifier       types.String `tfsdk:"display_identifier"`
+	OAuthTokenID            types.String `tfsdk:"oauth_token_id"`
+	GithubAppInstallationID types.String `tfsdk:"github_app_installation_id"`
+	Branch                  types.String `tfsdk:"branch"`
+	Tags                    types.Bool   `tfsdk:"tags"`
+}
+
+// modelFromTFERegistryModule builds a modelTFERegistryModule struct from a
+// tfe.RegistryModule value.
+func modelFromTFERegistryModule(v *tfe.RegistryModule) modelTFERegistryModule {
+	m := modelTFERegistryModule{
+		ID:                  types.StringValue(v.ID),
+		Organization:        types.StringValue(v.Organization.Name),
+		RegistryName:        types.StringValue(string(v.RegistryName)),
+		Namespace:           types.StringValue(v.Namespace),
+		Name:                types.StringValue(v.Name),
+		ModuleProvider:      types.StringValue(v.Provider),
+		Status:              types.StringValue(string(v.Status)),
+		PublishingMechanism: types.StringValue(string(v.PublishingMechanism)),
+		NoCode:              types.BoolValue(v.NoCode),
+		VCSRepo:             []modelTFERegistryModuleVCSRepo{},
+	}
+
+	if v.VCSRepo != nil {
+		m.VCSRepo = append(m.VCSRepo, modelTFERegistryModuleVCSRepo{
+			Identifier:              types.StringValue(v.VCSRepo.Identifier),
+			DisplayIdentifier:       types.StringValue(v.VCSRepo.DisplayIdentifier),
+			OAuthTokenID:            types.StringValue(v.VCSRepo.OAuthTokenID),
+			GithubAppInstallationID: types.StringValue(v.VCSRepo.GHAInstallationID),
+			Branch:                  types.StringValue(v.VCSRepo.Branch),
+			Tags:                    types.BoolValue(v.VCSRepo.Tags),
+		})
+	}
+
+	return m
+}
+
+// Metadata returns the data source type name.
+func (d *dataSourceTFERegistryModule) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_registry_module"
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSourceTFERegistryModule) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source can be used to retrieve a public or private module from the private registry.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the module.",
+				Computed:    true,
+			},
+			"organization": schema.StringAttribute{
+				Description: "Name of the organization. If omitted, organization must be defined in the provider config.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"registry_name": schema.StringAttribute{
+				Description: "Whether this is a publicly maintained module or private. Must be either `public` or `private`.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(tfe.PrivateRegistry),
+						string(tfe.PublicRegistry),
+					),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Description: "The namespace of the module. For private modules this is the same as the organization.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the module.",
+				Required:    true,
+			},
+			"module_provider": schema.StringAttribute{
+				Description: "Name of the provider the module is written for.",
+				Required:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The ingestion status of the module.",
+				Computed:    true,
+			},
+			"publishing_mechanism": schema.StringAttribute{
+				Description: "How the module is published, either `git_tag` or `branch`.",
+				Computed:    true,
+			},
+			"no_code": schema.BoolAttribute{
+				Description: "Whether the module is enabled for no-code provisioning.",
+				Computed:    true,
+			},
+			"vcs_repo": schema.ListAttribute{
+				Description: "The VCS repository the module is published from. Empty for modules that are not backed by VCS.",
+				Computed:    true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"identifier":                 types.StringType,
+						"display_identifier":         types.StringType,
+						"oauth_token_id":             types.StringType,
+						"github_app_installation_id": types.StringType,
+						"branch":                     types.StringType,
+						"tags":                       types.BoolType,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceTFERegistryModule) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config modelTFERegistryModule
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.RegistryName.ValueString() == "public" && config.Namespace.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("namespace"),
+			"Missing Attribute Configuration",
+			"Expected namespace to be configured when registry_name is \"public\".",
+		)
+	} else if (config.RegistryName.IsNull() || config.RegistryName.ValueString() == "private") && !config.Namespace.IsNull() && !config.Namespace.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("namespace"),
+			"Invalid Attribute Combination",
+			"The namespace attribute cannot be configured when registry_name is \"private\".",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dataSourceTFERegistryModule) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+
+		return
+	}
+	d.config = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dataSourceTFERegistryModule) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data modelTFERegistryModule
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var organization string
+	resp.Diagnostics.Append(d.config.dataOrDefaultOrganization(ctx, req.Config, &organization)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	registryName := tfe.PrivateRegistry
+	if !data.RegistryName.IsNull() {
+		registryName = tfe.RegistryName(data.RegistryName.ValueString())
+	}
+
+	namespace := organization
+	if registryName == tfe.PublicRegistry {
+		namespace = data.Namespace.ValueString()
+	}
+
+	moduleID := tfe.RegistryModuleID{
+		Organization: organization,
+		Name:         data.Name.ValueString(),
+		Provider:     data.ModuleProvider.ValueString(),
+		Namespace:    namespace,
+		RegistryName: registryName,
+	}
+
+	tflog.Debug(ctx, "Reading registry module", map[string]interface{}{"name": moduleID.Name, "provider": moduleID.Provider})
+	module, err := d.config.Client.RegistryModules.Read(ctx, moduleID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to read registry module %s/%s", moduleID.Name, moduleID.Provider), err.Error())
+		return
+	}
+
+	data = modelFromTFERegistryModule(module)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFERegistryModuleDataSource_private(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	orgName := fmt.Sprintf("tst-terraform-%d", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERegistryModuleDataSourceConfig_private(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_registry_module.foobar", "id",
+						"tfe_registry_module.foobar", "id"),
+					resource.TestCheckResourceAttr("data.tfe_registry_module.foobar", "organization", orgName),
+					resource.TestCheckResourceAttr("data.tfe_registry_module.foobar", "registry_name", "private"),
+					resource.TestCheckResourceAttr("data.tfe_registry_module.foobar", "namespace", orgName),
+					resource.TestCheckResourceAttr("data.tfe_registry_module.foobar", "name", "test_module"),
+					resource.TestCheckResourceAttr("data.tfe_registry_module.foobar", "module_provider", "my_provider"),
+					resource.TestCheckResourceAttrSet("data.tfe_registry_module.foobar", "status"),
+					resource.TestCheckResourceAttr("data.tfe_registry_module.foobar", "vcs_repo.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFERegistryModuleDataSourceConfig_private(rInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "tfe_registry_module" "foobar" {
+  organization    = tfe_organization.foobar.name
+  name            = tfe_registry_module.foobar.name
+  module_provider = tfe_registry_module.foobar.module_provider
+}
+`, testAccTFERegistryModule_privateRMWithoutRegistryName(rInt))
+}
@@ -9,6 +9,7 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
 
 	tfe "github.com/hashicorp/go-tfe"
@@ -43,30 +44,15 @@ func dataSourceTFESSHKeyRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	// Create an options struct.
-	options := &tfe.SSHKeyListOptions{}
-
-	for {
-		l, err := config.Client.SSHKeys.List(ctx, organization, options)
-		if err != nil {
-			return fmt.Errorf("Error retrieving SSH keys: %w", err)
-		}
-
-		for _, k := range l.Items {
-			if k.Name == name {
-				d.SetId(k.ID)
-				return nil
-			}
-		}
-
-		// Exit the loop when we've seen all pages.
-		if l.CurrentPage >= l.TotalPages {
-			break
+	sshKey, err := fetchSSHKey(organization, name, config.Client)
+	if err != nil {
+		if errors.Is(err, tfe.ErrResourceNotFound) {
+			return fmt.Errorf("could not find SSH key %s/%s", organization, name)
 		}
-
-		// Update the page number to get the next page.
-		options.PageNumber = l.NextPage
+		return err
 	}
 
-	return fmt.Errorf("could not find SSH key %s/%s", organization, name)
+	d.SetId(sshKey.ID)
+
+	return nil
 }
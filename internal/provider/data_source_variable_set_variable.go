@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dataSourceTFEVariableSetVariable{}
+	_ datasource.DataSourceWithConfigure = &dataSourceTFEVariableSetVariable{}
+)
+
+// NewVariableSetVariableDataSource is a helper function to simplify the provider implementation.
+func NewVariableSetVariableDataSource() datasource.DataSource {
+	return &dataSourceTFEVariableSetVariable{}
+}
+
+// dataSourceTFEVariableSetVariable is the data source implementation.
+type dataSourceTFEVariableSetVariable struct {
+	config ConfiguredClient
+}
+
+// modelTFEVariableSetVariable maps the data source schema data.
+type modelTFEVariableSetVariable struct {
+	ID            types.String `tfsdk:"id"`
+	VariableSetID types.String `tfsdk:"variable_set_id"`
+	Key           types.String `tfsdk:"key"`
+	Category      types.String `tfsdk:"category"`
+	Value         types.String `tfsdk:"value"`
+	Description   types.String `tfsdk:"description"`
+	HCL           types.Bool   `tfsdk:"hcl"`
+	Sensitive     types.Bool   `tfsdk:"sensitive"`
+}
+
+// Metadata returns the data source type name.
+func (d *dataSourceTFEVariableSetVariable) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variable_set_variable"
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSourceTFEVariableSetVariable) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source can be used to retrieve a single variable of a variable set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the variable.",
+				Computed:    true,
+			},
+			"variable_set_id": schema.StringAttribute{
+				Description: "ID of the variable set that owns the variable.",
+				Required:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "Name of the variable.",
+				Required:    true,
+			},
+			"category": schema.StringAttribute{
+				Description: "Whether this is a Terraform or environment variable. Only needed when the variable set has both kinds of variable with the same key.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(tfe.CategoryEnv),
+						string(tfe.CategoryTerraform),
+					),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "Value of the variable. Empty if the variable is sensitive.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the variable.",
+				Computed:    true,
+			},
+			"hcl": schema.BoolAttribute{
+				Description: "Whether to evaluate the value of the variable as a string of HCL code.",
+				Computed:    true,
+			},
+			"sensitive": schema.BoolAttribute{
+				Description: "Whether the value is sensitive.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dataSourceTFEVariableSetVariable) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+
+		return
+	}
+	d.config = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dataSourceTFEVariableSetVariable) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data modelTFEVariableSetVariable
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variableSetID := data.VariableSetID.ValueString()
+	key := data.Key.ValueString()
+	category := data.Category.ValueString()
+
+	var matches []*tfe.VariableSetVariable
+	options := &tfe.VariableSetVariableListOptions{}
+	for {
+		tflog.Debug(ctx, "Listing variable set variables", map[string]interface{}{"variable_set_id": variableSetID})
+		list, err := d.config.Client.VariableSetVariables.List(ctx, variableSetID, options)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to list variables of variable set %s", variableSetID), err.Error())
+			return
+		}
+
+		for _, v := range list.Items {
+			if v.Key == key && (category == "" || string(v.Category) == category) {
+				matches = append(matches, v)
+			}
+		}
+
+		if list.Pagination == nil || list.CurrentPage >= list.TotalPages {
+			break
+		}
+		options.PageNumber = list.NextPage
+	}
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Variable not found",
+			fmt.Sprintf("Could not find variable %s in variable set %s", key, variableSetID),
+		)
+		return
+	case 1:
+	default:
+		resp.Diagnostics.AddError(
+			"Multiple variables found",
+			fmt.Sprintf("Variable set %s has more than one variable with key %s; set category to select one of them", variableSetID, key),
+		)
+		return
+	}
+
+	v := matches[0]
+	data.ID = types.StringValue(v.ID)
+	data.Category = types.StringValue(string(v.Category))
+	data.Description = types.StringValue(v.Description)
+	data.HCL = types.BoolValue(v.HCL)
+	data.Sensitive = types.BoolValue(v.Sensitive)
+	// The API redacts sensitive values, so they are never exposed here.
+	data.Value = types.StringValue("")
+	if !v.Sensitive {
+		data.Value = types.StringValue(v.Value)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
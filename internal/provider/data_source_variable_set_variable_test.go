@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEVariableSetVariableDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEVariableSetVariableDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_variable_set_variable.plain", "id",
+						"tfe_variable.plain", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_variable_set_variable.plain", "category", "env"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_variable_set_variable.plain", "value", "value_test"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_variable_set_variable.plain", "description", "some description"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_variable_set_variable.plain", "hcl", "false"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_variable_set_variable.plain", "sensitive", "false"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_variable_set_variable.secret", "id",
+						"tfe_variable.secret", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_variable_set_variable.secret", "category", "terraform"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_variable_set_variable.secret", "sensitive", "true"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_variable_set_variable.secret", "value", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEVariableSetVariableDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_variable_set" "foobar" {
+  name         = "varset-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_variable" "plain" {
+  key             = "key_test"
+  value           = "value_test"
+  description     = "some description"
+  category        = "env"
+  variable_set_id = tfe_variable_set.foobar.id
+}
+
+resource "tfe_variable" "secret" {
+  key             = "secret_test"
+  value           = "secret_value"
+  category        = "terraform"
+  sensitive       = true
+  variable_set_id = tfe_variable_set.foobar.id
+}
+
+data "tfe_variable_set_variable" "plain" {
+  variable_set_id = tfe_variable_set.foobar.id
+  key             = tfe_variable.plain.key
+}
+
+data "tfe_variable_set_variable" "secret" {
+  variable_set_id = tfe_variable_set.foobar.id
+  key             = tfe_variable.secret.key
+  category        = "terraform"
+}
+`, rInt)
+}
@@ -208,6 +208,16 @@ func dataSourceTFEWorkspace() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"agent_pool_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_remote_run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -259,6 +269,18 @@ func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("working_directory", workspace.WorkingDirectory)
 	d.Set("execution_mode", workspace.ExecutionMode)
 
+	var agentPoolID string
+	if workspace.AgentPool != nil {
+		agentPoolID = workspace.AgentPool.ID
+	}
+	d.Set("agent_pool_id", agentPoolID)
+
+	var lastRemoteRunID string
+	if workspace.CurrentRun != nil {
+		lastRemoteRunID = workspace.CurrentRun.ID
+	}
+	d.Set("last_remote_run_id", lastRemoteRunID)
+
 	if workspace.Links["self-html"] != nil {
 		baseAPI := config.Client.BaseURL()
 		htmlURL := url.URL{
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dataSourceTFEWorkspaceCount{}
+	_ datasource.DataSourceWithConfigure = &dataSourceTFEWorkspaceCount{}
+)
+
+// NewWorkspaceCountDataSource is a helper function to simplify the provider implementation.
+func NewWorkspaceCountDataSource() datasource.DataSource {
+	return &dataSourceTFEWorkspaceCount{}
+}
+
+// dataSourceTFEWorkspaceCount is the data source implementation.
+type dataSourceTFEWorkspaceCount struct {
+	config ConfiguredClient
+}
+
+// modelTFEWorkspaceCount maps the data source schema data.
+type modelTFEWorkspaceCount struct {
+	ID             types.String `tfsdk:"id"`
+	Organization   types.String `tfsdk:"organization"`
+	TagNames       types.List   `tfsdk:"tag_names"`
+	ExcludeTags    types.Set    `tfsdk:"exclude_tags"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	WorkspaceCount types.Int64  `tfsdk:"workspace_count"`
+}
+
+// Metadata returns the data source type name.
+func (d *dataSourceTFEWorkspaceCount) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_count"
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSourceTFEWorkspaceCount) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source can be used to count the workspaces of an organization without listing all of them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"organization": schema.StringAttribute{
+				Description: "Name of the organization. If omitted, organization must be defined in the provider config.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag_names": schema.ListAttribute{
+				Description: "Only count workspaces that have all of these tags.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"exclude_tags": schema.SetAttribute{
+				Description: "Don't count workspaces that have any of these tags.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Only count workspaces in this project.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						IDPattern("prj"),
+						"must be a valid project ID (prj-<RANDOM STRING>)",
+					),
+				},
+			},
+			"workspace_count": schema.Int64Attribute{
+				Description: "The number of matching workspaces.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dataSourceTFEWorkspaceCount) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+
+		return
+	}
+	d.config = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dataSourceTFEWorkspaceCount) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data modelTFEWorkspaceCount
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var organization string
+	resp.Diagnostics.Append(d.config.dataOrDefaultOrganization(ctx, req.Config, &organization)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tagNames []string
+	resp.Diagnostics.Append(data.TagNames.ElementsAs(ctx, &tagNames, false)...)
+	var excludeTags []string
+	resp.Diagnostics.Append(data.ExcludeTags.ElementsAs(ctx, &excludeTags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the pagination metadata is needed, so a single small page is
+	// enough no matter how many workspaces match.
+	options := &tfe.WorkspaceListOptions{
+		ListOptions: tfe.ListOptions{PageSize: 1},
+		Tags:        strings.Join(tagNames, ","),
+		ExcludeTags: strings.Join(excludeTags, ","),
+		ProjectID:   data.ProjectID.ValueString(),
+	}
+
+	tflog.Debug(ctx, "Counting workspaces", map[string]interface{}{"organization": organization})
+	wl, err := d.config.Client.Workspaces.List(ctx, organization, options)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to list workspaces of organization %s", organization), err.Error())
+		return
+	}
+
+	count := len(wl.Items)
+	if wl.Pagination != nil {
+		count = wl.TotalCount
+	}
+
+	data.ID = types.StringValue(organization)
+	data.Organization = types.StringValue(organization)
+	data.WorkspaceCount = types.Int64Value(int64(count))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEWorkspaceCountDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	orgName := fmt.Sprintf("tst-terraform-%d", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceCountDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.tfe_workspace_count.all", "id", orgName),
+					resource.TestCheckResourceAttr("data.tfe_workspace_count.all", "workspace_count", "3"),
+					resource.TestCheckResourceAttr("data.tfe_workspace_count.tagged", "workspace_count", "2"),
+					resource.TestCheckResourceAttr("data.tfe_workspace_count.excluded", "workspace_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEWorkspaceCountDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foo" {
+  name         = "workspace-foo"
+  organization = tfe_organization.foobar.id
+  tag_names    = ["good"]
+}
+
+resource "tfe_workspace" "bar" {
+  name         = "workspace-bar"
+  organization = tfe_organization.foobar.id
+  tag_names    = ["good", "extra"]
+}
+
+resource "tfe_workspace" "baz" {
+  name         = "workspace-baz"
+  organization = tfe_organization.foobar.id
+}
+
+data "tfe_workspace_count" "all" {
+  organization = tfe_organization.foobar.name
+
+  depends_on = [tfe_workspace.foo, tfe_workspace.bar, tfe_workspace.baz]
+}
+
+data "tfe_workspace_count" "tagged" {
+  organization = tfe_organization.foobar.name
+  tag_names    = ["good"]
+
+  depends_on = [tfe_workspace.foo, tfe_workspace.bar, tfe_workspace.baz]
+}
+
+data "tfe_workspace_count" "excluded" {
+  organization = tfe_organization.foobar.name
+  tag_names    = ["good"]
+  exclude_tags = ["extra"]
+
+  depends_on = [tfe_workspace.foo, tfe_workspace.bar, tfe_workspace.baz]
+}`, rInt)
+}
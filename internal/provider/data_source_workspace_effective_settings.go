@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// effectiveSettingSourceWorkspace means the setting is configured on the
+	// workspace itself.
+	effectiveSettingSourceWorkspace = "workspace"
+	// effectiveSettingSourceOrganization means the workspace inherits the
+	// setting from its organization's defaults.
+	effectiveSettingSourceOrganization = "organization"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dataSourceTFEWorkspaceEffectiveSettings{}
+	_ datasource.DataSourceWithConfigure = &dataSourceTFEWorkspaceEffectiveSettings{}
+)
+
+// NewWorkspaceEffectiveSettingsDataSource is a helper function to simplify the provider implementation.
+func NewWorkspaceEffectiveSettingsDataSource() datasource.DataSource {
+	return &dataSourceTFEWorkspaceEffectiveSettings{}
+}
+
+// dataSourceTFEWorkspaceEffectiveSettings is the data source implementation.
+type dataSourceTFEWorkspaceEffectiveSettings struct {
+	config ConfiguredClient
+}
+
+// modelTFEWorkspaceEffectiveSettings maps the data source schema data.
+type modelTFEWorkspaceEffectiveSettings struct {
+	ID                               types.String `tfsdk:"id"`
+	WorkspaceID                      types.String `tfsdk:"workspace_id"`
+	ExecutionMode                    types.String `tfsdk:"execution_mode"`
+	ExecutionModeSource              types.String `tfsdk:"execution_mode_source"`
+	AgentPoolID                      types.String `tfsdk:"agent_pool_id"`
+	AgentPoolIDSource                types.String `tfsdk:"agent_pool_id_source"`
+	StructuredRunOutputEnabled       types.Bool   `tfsdk:"structured_run_output_enabled"`
+	StructuredRunOutputEnabledSource types.String `tfsdk:"structured_run_output_enabled_source"`
+}
+
+// modelFromTFEWorkspaceEffectiveSettings builds a
+// modelTFEWorkspaceEffectiveSettings struct from a tfe.Workspace value.
+func modelFromTFEWorkspaceEffectiveSettings(ws *tfe.Workspace) modelTFEWorkspaceEffectiveSettings {
+	// Servers that don't report setting overwrites don't support organization
+	// defaults, so every setting comes from the workspace itself.
+	executionModeSource := effectiveSettingSourceWorkspace
+	agentPoolSource := effectiveSettingSourceWorkspace
+	if ws.SettingOverwrites != nil {
+		if ws.SettingOverwrites.ExecutionMode != nil && !*ws.SettingOverwrites.ExecutionMode {
+			executionModeSource = effectiveSettingSourceOrganization
+		}
+		if ws.SettingOverwrites.AgentPool != nil && !*ws.SettingOverwrites.AgentPool {
+			agentPoolSource = effectiveSettingSourceOrganization
+		}
+	}
+
+	agentPoolID := types.StringNull()
+	if ws.AgentPool != nil && ws.ExecutionMode == "agent" {
+		agentPoolID = types.StringValue(ws.AgentPool.ID)
+	}
+
+	return modelTFEWorkspaceEffectiveSettings{
+		ID:                               types.StringValue(ws.ID),
+		WorkspaceID:                      types.StringValue(ws.ID),
+		ExecutionMode:                    types.StringValue(ws.ExecutionMode),
+		ExecutionModeSource:              types.StringValue(executionModeSource),
+		AgentPoolID:                      agentPoolID,
+		AgentPoolIDSource:                types.StringValue(agentPoolSource),
+		StructuredRunOutputEnabled:       types.BoolValue(ws.StructuredRunOutputEnabled),
+		StructuredRunOutputEnabledSource: types.StringValue(effectiveSettingSourceWorkspace),
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *dataSourceTFEWorkspaceEffectiveSettings) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_effective_settings"
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSourceTFEWorkspaceEffectiveSettings) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	sourceDescription := fmt.Sprintf("Where the setting comes from, either `%s` or `%s`.", effectiveSettingSourceWorkspace, effectiveSettingSourceOrganization)
+
+	resp.Schema = schema.Schema{
+		Description: "This data source can be used to retrieve the effective settings of a workspace, including the settings it inherits from its organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "ID of the workspace.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						IDPattern("ws"),
+						"must be a valid workspace ID (ws-<RANDOM STRING>)",
+					),
+				},
+			},
+			"execution_mode": schema.StringAttribute{
+				Description: "The effective execution mode of the workspace.",
+				Computed:    true,
+			},
+			"execution_mode_source": schema.StringAttribute{
+				Description: sourceDescription,
+				Computed:    true,
+			},
+			"agent_pool_id": schema.StringAttribute{
+				Description: "The effective agent pool of the workspace, if the execution mode is `agent`.",
+				Computed:    true,
+			},
+			"agent_pool_id_source": schema.StringAttribute{
+				Description: sourceDescription,
+				Computed:    true,
+			},
+			"structured_run_output_enabled": schema.BoolAttribute{
+				Description: "Whether the workspace shows run output using the enhanced UI.",
+				Computed:    true,
+			},
+			"structured_run_output_enabled_source": schema.StringAttribute{
+				Description: sourceDescription,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dataSourceTFEWorkspaceEffectiveSettings) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+
+		return
+	}
+	d.config = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dataSourceTFEWorkspaceEffectiveSettings) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data modelTFEWorkspaceEffectiveSettings
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := data.WorkspaceID.ValueString()
+
+	tflog.Debug(ctx, "Reading workspace", map[string]interface{}{"workspace_id": workspaceID})
+	ws, err := d.config.Client.Workspaces.ReadByID(ctx, workspaceID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to read workspace %s", workspaceID), err.Error())
+		return
+	}
+
+	data = modelFromTFEWorkspaceEffectiveSettings(ws)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestModelFromTFEWorkspaceEffectiveSettings(t *testing.T) {
+	tests := map[string]struct {
+		ws                  *tfe.Workspace
+		executionModeSource string
+		agentPoolSource     string
+		agentPoolID         string
+	}{
+		"inherited from organization": {
+			ws: &tfe.Workspace{
+				ID:            "ws-inherited",
+				ExecutionMode: "remote",
+				SettingOverwrites: &tfe.WorkspaceSettingOverwrites{
+					ExecutionMode: tfe.Bool(false),
+					AgentPool:     tfe.Bool(false),
+				},
+			},
+			executionModeSource: "organization",
+			agentPoolSource:     "organization",
+		},
+		"set on workspace": {
+			ws: &tfe.Workspace{
+				ID:            "ws-overwritten",
+				ExecutionMode: "agent",
+				AgentPool:     &tfe.AgentPool{ID: "apool-123"},
+				SettingOverwrites: &tfe.WorkspaceSettingOverwrites{
+					ExecutionMode: tfe.Bool(true),
+					AgentPool:     tfe.Bool(true),
+				},
+			},
+			executionModeSource: "workspace",
+			agentPoolSource:     "workspace",
+			agentPoolID:         "apool-123",
+		},
+		"no overwrites support": {
+			ws: &tfe.Workspace{
+				ID:            "ws-legacy",
+				ExecutionMode: "local",
+			},
+			executionModeSource: "workspace",
+			agentPoolSource:     "workspace",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			model := modelFromTFEWorkspaceEffectiveSettings(test.ws)
+
+			if model.ExecutionMode.ValueString() != test.ws.ExecutionMode {
+				t.Errorf("execution_mode = %q, want %q", model.ExecutionMode.ValueString(), test.ws.ExecutionMode)
+			}
+			if model.ExecutionModeSource.ValueString() != test.executionModeSource {
+				t.Errorf("execution_mode_source = %q, want %q", model.ExecutionModeSource.ValueString(), test.executionModeSource)
+			}
+			if model.AgentPoolIDSource.ValueString() != test.agentPoolSource {
+				t.Errorf("agent_pool_id_source = %q, want %q", model.AgentPoolIDSource.ValueString(), test.agentPoolSource)
+			}
+			if model.AgentPoolID.ValueString() != test.agentPoolID {
+				t.Errorf("agent_pool_id = %q, want %q", model.AgentPoolID.ValueString(), test.agentPoolID)
+			}
+		})
+	}
+}
+
+func TestAccTFEWorkspaceEffectiveSettingsDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceEffectiveSettingsDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_effective_settings.foobar", "id",
+						"tfe_workspace.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_effective_settings.foobar", "execution_mode", "local"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_effective_settings.foobar", "execution_mode_source", "workspace"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_effective_settings.foobar", "structured_run_output_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_effective_settings.foobar", "structured_run_output_enabled_source", "workspace"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEWorkspaceEffectiveSettingsDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace_settings" "foobar" {
+  workspace_id   = tfe_workspace.foobar.id
+  execution_mode = "local"
+}
+
+data "tfe_workspace_effective_settings" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+
+  depends_on = [tfe_workspace_settings.foobar]
+}
+`, rInt)
+}
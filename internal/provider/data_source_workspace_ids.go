@@ -25,7 +25,7 @@ func dataSourceTFEWorkspaceIDs() *schema.Resource {
 				Type:         schema.TypeList,
 				Elem:         &schema.Schema{Type: schema.TypeString},
 				Optional:     true,
-				AtLeastOneOf: []string{"names", "tag_names"},
+				AtLeastOneOf: []string{"names", "tag_names", "project_id"},
 			},
 
 			"tag_names": {
@@ -45,6 +45,11 @@ func dataSourceTFEWorkspaceIDs() *schema.Resource {
 				Optional: true,
 			},
 
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"ids": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -148,7 +153,14 @@ func dataSourceTFEWorkspaceIDsRead(d *schema.ResourceData, meta interface{}) err
 		options.Tags = tagSearch
 	}
 
-	hasOnlyTags := len(tagSearchParts) > 0 && len(names) == 0
+	// Only list the workspaces of a project.
+	if projectID, ok := d.GetOk("project_id"); ok {
+		id += projectID.(string) // add to the state id
+		options.ProjectID = projectID.(string)
+	}
+
+	// Without names, every workspace matching the tag or project filters is included.
+	hasOnlyFilters := (len(tagSearchParts) > 0 || options.ProjectID != "") && len(names) == 0
 
 	for {
 		wl, err := config.Client.Workspaces.List(ctx, organization, options)
@@ -165,7 +177,7 @@ func dataSourceTFEWorkspaceIDsRead(d *schema.ResourceData, meta interface{}) err
 					break
 				}
 			}
-			if (hasOnlyTags || includedByName(names, w.Name)) && !hasExcludedTag {
+			if (hasOnlyFilters || includedByName(names, w.Name)) && !hasExcludedTag {
 				fullNames[w.Name] = organization + "/" + w.Name
 				ids[w.Name] = w.ID
 			}
@@ -345,6 +345,42 @@ func TestAccTFEWorkspaceIDsDataSource_tags(t *testing.T) {
 	})
 }
 
+func TestAccTFEWorkspaceIDsDataSource_project(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceIDsDataSourceConfig_project(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// project attribute
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_ids.project", "project_id", "tfe_project.foobar", "id"),
+
+					// ids attribute
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_ids.project", "ids.%", "2"),
+					resource.TestCheckResourceAttrSet(
+						"data.tfe_workspace_ids.project", fmt.Sprintf("ids.workspace-foo-%d", rInt)),
+					resource.TestCheckResourceAttrSet(
+						"data.tfe_workspace_ids.project", fmt.Sprintf("ids.workspace-bar-%d", rInt)),
+
+					// names and project combined
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_ids.project_and_name", "ids.%", "1"),
+					resource.TestCheckResourceAttrSet(
+						"data.tfe_workspace_ids.project_and_name", fmt.Sprintf("ids.workspace-foo-%d", rInt)),
+
+					// id attribute
+					resource.TestCheckResourceAttrSet("data.tfe_workspace_ids.project", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFEWorkspaceIDsDataSource_searchByTagAndName(t *testing.T) {
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
 	orgName := fmt.Sprintf("tst-terraform-%d", rInt)
@@ -394,7 +430,7 @@ func TestAccTFEWorkspaceIDsDataSource_empty(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccTFEWorkspaceIDsDataSourceConfig_empty(rInt),
-				ExpectError: regexp.MustCompile("one of `names,tag_names` must be specified"),
+				ExpectError: regexp.MustCompile("one of `names,project_id,tag_names` must be specified"),
 			},
 		},
 	})
@@ -603,6 +639,57 @@ data "tfe_workspace_ids" "good" {
 }`, rInt, rInt, rInt, rInt)
 }
 
+func testAccTFEWorkspaceIDsDataSourceConfig_project(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_project" "foobar" {
+  name         = "project-%d"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "foo" {
+  name         = "workspace-foo-%d"
+  organization = tfe_organization.foobar.id
+  project_id   = tfe_project.foobar.id
+}
+
+resource "tfe_workspace" "bar" {
+  name         = "workspace-bar-%d"
+  organization = tfe_organization.foobar.id
+  project_id   = tfe_project.foobar.id
+}
+
+resource "tfe_workspace" "dummy" {
+  name         = "workspace-dummy-%d"
+  organization = tfe_organization.foobar.id
+}
+
+data "tfe_workspace_ids" "project" {
+  project_id   = tfe_project.foobar.id
+  organization = tfe_organization.foobar.id
+  depends_on = [
+    tfe_workspace.foo,
+    tfe_workspace.bar,
+    tfe_workspace.dummy
+  ]
+}
+
+data "tfe_workspace_ids" "project_and_name" {
+  names        = ["*-foo-*"]
+  project_id   = tfe_project.foobar.id
+  organization = tfe_organization.foobar.id
+  depends_on = [
+    tfe_workspace.foo,
+    tfe_workspace.bar,
+    tfe_workspace.dummy
+  ]
+}`, rInt, rInt, rInt, rInt, rInt)
+}
+
 func testAccTFEWorkspaceIDsDataSourceConfig_empty(rInt int) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
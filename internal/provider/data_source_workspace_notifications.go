@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dataSourceTFEWorkspaceNotifications{}
+	_ datasource.DataSourceWithConfigure = &dataSourceTFEWorkspaceNotifications{}
+)
+
+// NewWorkspaceNotificationsDataSource is a helper function to simplify the provider implementation.
+func NewWorkspaceNotificationsDataSource() datasource.DataSource {
+	return &dataSourceTFEWorkspaceNotifications{}
+}
+
+// dataSourceTFEWorkspaceNotifications is the data source implementation.
+type dataSourceTFEWorkspaceNotifications struct {
+	config ConfiguredClient
+}
+
+// modelTFEWorkspaceNotifications maps the data source schema data.
+type modelTFEWorkspaceNotifications struct {
+	ID                         types.String                    `tfsdk:"id"`
+	WorkspaceID                types.String                    `tfsdk:"workspace_id"`
+	NotificationConfigurations []modelTFEWorkspaceNotification `tfsdk:"notification_configurations"`
+}
+
+// modelTFEWorkspaceNotification maps a single notification configuration
+// in the data source schema data.
+type modelTFEWorkspaceNotification struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	DestinationType types.String `tfsdk:"destination_type"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Triggers        types.Set    `tfsdk:"triggers"`
+}
+
+// modelFromTFENotificationConfiguration builds a
+// modelTFEWorkspaceNotification struct from a tfe.NotificationConfiguration
+// value.
+func modelFromTFENotificationConfiguration(v *tfe.NotificationConfiguration) modelTFEWorkspaceNotification {
+	triggers := make([]attr.Value, len(v.Triggers))
+	for i, trigger := range v.Triggers {
+		triggers[i] = types.StringValue(trigger)
+	}
+
+	return modelTFEWorkspaceNotification{
+		ID:              types.StringValue(v.ID),
+		Name:            types.StringValue(v.Name),
+		DestinationType: types.StringValue(string(v.DestinationType)),
+		Enabled:         types.BoolValue(v.Enabled),
+		Triggers:        types.SetValueMust(types.StringType, triggers),
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *dataSourceTFEWorkspaceNotifications) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_notifications"
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSourceTFEWorkspaceNotifications) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source can be used to retrieve all notification configurations of a workspace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "ID of the workspace.",
+				Required:    true,
+			},
+			"notification_configurations": schema.ListAttribute{
+				Description: "List of notification configurations of the workspace.",
+				Computed:    true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"id":               types.StringType,
+						"name":             types.StringType,
+						"destination_type": types.StringType,
+						"enabled":          types.BoolType,
+						"triggers":         types.SetType{ElemType: types.StringType},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dataSourceTFEWorkspaceNotifications) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+
+		return
+	}
+	d.config = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dataSourceTFEWorkspaceNotifications) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data modelTFEWorkspaceNotifications
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := data.WorkspaceID.ValueString()
+	options := &tfe.NotificationConfigurationListOptions{}
+
+	tflog.Debug(ctx, "Listing notification configurations", map[string]interface{}{"workspace_id": workspaceID})
+	ncList, err := d.config.Client.NotificationConfigurations.List(ctx, workspaceID, options)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to list notification configurations for workspace %s", workspaceID), err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(workspaceID)
+	data.NotificationConfigurations = []modelTFEWorkspaceNotification{}
+
+	for {
+		for _, nc := range ncList.Items {
+			data.NotificationConfigurations = append(data.NotificationConfigurations, modelFromTFENotificationConfiguration(nc))
+		}
+
+		if ncList.Pagination == nil || ncList.CurrentPage >= ncList.TotalPages {
+			break
+		}
+		options.PageNumber = ncList.NextPage
+
+		tflog.Debug(ctx, "Listing notification configurations", map[string]interface{}{"workspace_id": workspaceID})
+		ncList, err = d.config.Client.NotificationConfigurations.List(ctx, workspaceID, options)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to list notification configurations for workspace %s", workspaceID), err.Error())
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
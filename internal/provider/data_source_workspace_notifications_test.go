@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEWorkspaceNotificationsDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceNotificationsDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_notifications.all", "workspace_id",
+						"tfe_workspace.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_notifications.all", "notification_configurations.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_notifications.all", "notification_configurations.0.id",
+						"tfe_notification_configuration.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_notifications.all", "notification_configurations.0.name", "notification_basic"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_notifications.all", "notification_configurations.0.destination_type", "generic"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_notifications.all", "notification_configurations.0.enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_notifications.all", "notification_configurations.0.triggers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEWorkspaceNotificationsDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "tfe_workspace_notifications" "all" {
+  workspace_id = tfe_workspace.foobar.id
+
+  depends_on = [tfe_notification_configuration.foobar]
+}
+`, testAccTFENotificationConfiguration_basic(rInt))
+}
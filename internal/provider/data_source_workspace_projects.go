@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dataSourceTFEWorkspaceProjects{}
+	_ datasource.DataSourceWithConfigure = &dataSourceTFEWorkspaceProjects{}
+)
+
+// NewWorkspaceProjectsDataSource is a helper function to simplify the provider implementation.
+func NewWorkspaceProjectsDataSource() datasource.DataSource {
+	return &dataSourceTFEWorkspaceProjects{}
+}
+
+// dataSourceTFEWorkspaceProjects is the data source implementation.
+type dataSourceTFEWorkspaceProjects struct {
+	config ConfiguredClient
+}
+
+// modelTFEWorkspaceProjects maps the data source schema data.
+type modelTFEWorkspaceProjects struct {
+	ID          types.String               `tfsdk:"id"`
+	WorkspaceID types.String               `tfsdk:"workspace_id"`
+	Projects    []modelTFEWorkspaceProject `tfsdk:"projects"`
+}
+
+// modelTFEWorkspaceProject maps a single project in the data source schema
+// data.
+type modelTFEWorkspaceProject struct {
+	ProjectID   types.String `tfsdk:"project_id"`
+	ProjectName types.String `tfsdk:"project_name"`
+}
+
+// Metadata returns the data source type name.
+func (d *dataSourceTFEWorkspaceProjects) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_projects"
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSourceTFEWorkspaceProjects) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source can be used to retrieve the projects a workspace belongs to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "ID of the workspace.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						IDPattern("ws"),
+						"must be a valid workspace ID (ws-<RANDOM STRING>)",
+					),
+				},
+			},
+			"projects": schema.ListAttribute{
+				Description: "List of projects the workspace belongs to.",
+				Computed:    true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"project_id":   types.StringType,
+						"project_name": types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dataSourceTFEWorkspaceProjects) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+
+		return
+	}
+	d.config = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dataSourceTFEWorkspaceProjects) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data modelTFEWorkspaceProjects
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := data.WorkspaceID.ValueString()
+
+	tflog.Debug(ctx, "Reading workspace", map[string]interface{}{"workspace_id": workspaceID})
+	workspace, err := d.config.Client.Workspaces.ReadByIDWithOptions(ctx, workspaceID, &tfe.WorkspaceReadOptions{
+		Include: []tfe.WSIncludeOpt{tfe.WSProject},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to read workspace %s", workspaceID), err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(workspaceID)
+	data.Projects = []modelTFEWorkspaceProject{}
+
+	// A workspace currently belongs to exactly one project, but the list
+	// leaves room for workspaces that belong to several.
+	if workspace.Project != nil {
+		data.Projects = append(data.Projects, modelTFEWorkspaceProject{
+			ProjectID:   types.StringValue(workspace.Project.ID),
+			ProjectName: types.StringValue(workspace.Project.Name),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
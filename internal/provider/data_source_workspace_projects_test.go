@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEWorkspaceProjectsDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceProjectsDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_projects.foobar", "id",
+						"tfe_workspace.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_projects.foobar", "projects.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_projects.foobar", "projects.0.project_id",
+						"tfe_project.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_projects.foobar", "projects.0.project_name", "project-test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEWorkspaceProjectsDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_project" "foobar" {
+  name         = "project-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+  project_id   = tfe_project.foobar.id
+}
+
+data "tfe_workspace_projects" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+}`, rInt)
+}
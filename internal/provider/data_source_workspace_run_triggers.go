@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dataSourceTFEWorkspaceRunTriggers{}
+	_ datasource.DataSourceWithConfigure = &dataSourceTFEWorkspaceRunTriggers{}
+)
+
+// NewWorkspaceRunTriggersDataSource is a helper function to simplify the provider implementation.
+func NewWorkspaceRunTriggersDataSource() datasource.DataSource {
+	return &dataSourceTFEWorkspaceRunTriggers{}
+}
+
+// dataSourceTFEWorkspaceRunTriggers is the data source implementation.
+type dataSourceTFEWorkspaceRunTriggers struct {
+	config ConfiguredClient
+}
+
+// modelTFEWorkspaceRunTriggers maps the data source schema data.
+type modelTFEWorkspaceRunTriggers struct {
+	ID               types.String                  `tfsdk:"id"`
+	WorkspaceID      types.String                  `tfsdk:"workspace_id"`
+	TriggerDirection types.String                  `tfsdk:"trigger_direction"`
+	RunTriggers      []modelTFEWorkspaceRunTrigger `tfsdk:"run_triggers"`
+}
+
+// modelTFEWorkspaceRunTrigger maps a single run trigger in the data source
+// schema data.
+type modelTFEWorkspaceRunTrigger struct {
+	ID                     types.String `tfsdk:"id"`
+	SourceWorkspaceID      types.String `tfsdk:"source_workspace_id"`
+	DestinationWorkspaceID types.String `tfsdk:"destination_workspace_id"`
+}
+
+// modelFromTFERunTrigger builds a modelTFEWorkspaceRunTrigger struct from a
+// tfe.RunTrigger value.
+func modelFromTFERunTrigger(v *tfe.RunTrigger) modelTFEWorkspaceRunTrigger {
+	sourceID := types.StringNull()
+	if v.SourceableChoice != nil && v.SourceableChoice.Workspace != nil {
+		sourceID = types.StringValue(v.SourceableChoice.Workspace.ID)
+	} else if v.Sourceable != nil {
+		sourceID = types.StringValue(v.Sourceable.ID)
+	}
+
+	destinationID := types.StringNull()
+	if v.Workspace != nil {
+		destinationID = types.StringValue(v.Workspace.ID)
+	}
+
+	return modelTFEWorkspaceRunTrigger{
+		ID:                     types.StringValue(v.ID),
+		SourceWorkspaceID:      sourceID,
+		DestinationWorkspaceID: destinationID,
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *dataSourceTFEWorkspaceRunTriggers) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_run_triggers"
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSourceTFEWorkspaceRunTriggers) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source can be used to retrieve the inbound and outbound run triggers of a workspace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "ID of the workspace.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						IDPattern("ws"),
+						"must be a valid workspace ID (ws-<RANDOM STRING>)",
+					),
+				},
+			},
+			"trigger_direction": schema.StringAttribute{
+				Description: fmt.Sprintf("Only return run triggers in this direction, either `%s` or `%s`. Both directions are returned when unset.", tfe.RunTriggerInbound, tfe.RunTriggerOutbound),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(tfe.RunTriggerInbound),
+						string(tfe.RunTriggerOutbound),
+					),
+				},
+			},
+			"run_triggers": schema.ListAttribute{
+				Description: "List of run triggers of the workspace.",
+				Computed:    true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"id":                       types.StringType,
+						"source_workspace_id":      types.StringType,
+						"destination_workspace_id": types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dataSourceTFEWorkspaceRunTriggers) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+
+		return
+	}
+	d.config = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dataSourceTFEWorkspaceRunTriggers) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data modelTFEWorkspaceRunTriggers
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := data.WorkspaceID.ValueString()
+
+	directions := []tfe.RunTriggerFilterOp{tfe.RunTriggerInbound, tfe.RunTriggerOutbound}
+	if !data.TriggerDirection.IsNull() {
+		directions = []tfe.RunTriggerFilterOp{tfe.RunTriggerFilterOp(data.TriggerDirection.ValueString())}
+	}
+
+	data.ID = types.StringValue(workspaceID)
+	data.RunTriggers = []modelTFEWorkspaceRunTrigger{}
+
+	for _, direction := range directions {
+		options := &tfe.RunTriggerListOptions{
+			RunTriggerType: direction,
+		}
+
+		for {
+			tflog.Debug(ctx, "Listing run triggers", map[string]interface{}{"workspace_id": workspaceID, "direction": direction})
+			rtList, err := d.config.Client.RunTriggers.List(ctx, workspaceID, options)
+			if err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Unable to list %s run triggers for workspace %s", direction, workspaceID), err.Error())
+				return
+			}
+
+			for _, rt := range rtList.Items {
+				data.RunTriggers = append(data.RunTriggers, modelFromTFERunTrigger(rt))
+			}
+
+			if rtList.Pagination == nil || rtList.CurrentPage >= rtList.TotalPages {
+				break
+			}
+			options.PageNumber = rtList.NextPage
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
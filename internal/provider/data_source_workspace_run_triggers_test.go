@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEWorkspaceRunTriggersDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceRunTriggersDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Both directions
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_run_triggers.all", "run_triggers.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_run_triggers.all", "run_triggers.0.id",
+						"tfe_run_trigger.foobar", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_run_triggers.all", "run_triggers.0.source_workspace_id",
+						"tfe_workspace.sourceable", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_run_triggers.all", "run_triggers.0.destination_workspace_id",
+						"tfe_workspace.workspace", "id"),
+					// Inbound only
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_run_triggers.inbound", "run_triggers.#", "1"),
+					// Outbound only
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_run_triggers.outbound", "run_triggers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEWorkspaceRunTriggersDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "tfe_workspace_run_triggers" "all" {
+  workspace_id = tfe_workspace.workspace.id
+
+  depends_on = [tfe_run_trigger.foobar]
+}
+
+data "tfe_workspace_run_triggers" "inbound" {
+  workspace_id      = tfe_workspace.workspace.id
+  trigger_direction = "inbound"
+
+  depends_on = [tfe_run_trigger.foobar]
+}
+
+data "tfe_workspace_run_triggers" "outbound" {
+  workspace_id      = tfe_workspace.workspace.id
+  trigger_direction = "outbound"
+
+  depends_on = [tfe_run_trigger.foobar]
+}
+`, testAccTFERunTrigger_basic(rInt))
+}
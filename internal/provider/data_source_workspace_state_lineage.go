@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dataSourceTFEWorkspaceStateLineage{}
+	_ datasource.DataSourceWithConfigure = &dataSourceTFEWorkspaceStateLineage{}
+)
+
+// NewWorkspaceStateLineageDataSource is a helper function to simplify the provider implementation.
+func NewWorkspaceStateLineageDataSource() datasource.DataSource {
+	return &dataSourceTFEWorkspaceStateLineage{}
+}
+
+// dataSourceTFEWorkspaceStateLineage is the data source implementation.
+type dataSourceTFEWorkspaceStateLineage struct {
+	config ConfiguredClient
+}
+
+// modelTFEWorkspaceStateLineage maps the data source schema data.
+type modelTFEWorkspaceStateLineage struct {
+	ID             types.String `tfsdk:"id"`
+	WorkspaceID    types.String `tfsdk:"workspace_id"`
+	StateVersionID types.String `tfsdk:"state_version_id"`
+	Lineage        types.String `tfsdk:"lineage"`
+	Serial         types.Int64  `tfsdk:"serial"`
+}
+
+// Metadata returns the data source type name.
+func (d *dataSourceTFEWorkspaceStateLineage) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_state_lineage"
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSourceTFEWorkspaceStateLineage) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source can be used to retrieve the lineage of a workspace's current state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "ID of the workspace.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						workspaceIDRegexp,
+						"must be a valid workspace ID (ws-<RANDOM STRING>)",
+					),
+				},
+			},
+			"state_version_id": schema.StringAttribute{
+				Description: "ID of the workspace's current state version.",
+				Computed:    true,
+			},
+			"lineage": schema.StringAttribute{
+				Description: "Lineage of the workspace's current state.",
+				Computed:    true,
+			},
+			"serial": schema.Int64Attribute{
+				Description: "Serial of the workspace's current state.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dataSourceTFEWorkspaceStateLineage) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+
+		return
+	}
+	d.config = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dataSourceTFEWorkspaceStateLineage) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data modelTFEWorkspaceStateLineage
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := data.WorkspaceID.ValueString()
+
+	tflog.Debug(ctx, "Reading current state version", map[string]interface{}{"workspace_id": workspaceID})
+	sv, err := d.config.Client.StateVersions.ReadCurrent(ctx, workspaceID)
+	if err != nil {
+		if errors.Is(err, tfe.ErrResourceNotFound) {
+			resp.Diagnostics.AddError(
+				"Unable to read current state version",
+				fmt.Sprintf("Workspace %s does not exist or does not have any state yet.", workspaceID),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read current state version", err.Error())
+		return
+	}
+
+	// The state version API does not return the lineage, so it has to be read
+	// from the raw state file.
+	tflog.Debug(ctx, "Downloading current state", map[string]interface{}{"state_version_id": sv.ID})
+	raw, err := d.config.Client.StateVersions.Download(ctx, sv.DownloadURL)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to download current state", err.Error())
+		return
+	}
+
+	var state struct {
+		Lineage string `json:"lineage"`
+		Serial  int64  `json:"serial"`
+	}
+	if err := json.Unmarshal(raw, &state); err != nil {
+		resp.Diagnostics.AddError("Unable to parse current state", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(sv.ID)
+	data.StateVersionID = types.StringValue(sv.ID)
+	data.Lineage = types.StringValue(state.Lineage)
+	data.Serial = types.Int64Value(state.Serial)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
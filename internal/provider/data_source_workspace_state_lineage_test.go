@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEWorkspaceStateLineageDataSource_basic(t *testing.T) {
+	skipIfUnitTest(t)
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatalf("error getting client %v", err)
+	}
+
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	fileName := "test-fixtures/state-versions/terraform.tfstate"
+	orgName, wsName, orgCleanup := createStateVersion(t, tfeClient, rInt, fileName)
+	t.Cleanup(orgCleanup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceStateLineageDataSourceConfig(orgName, wsName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_state_lineage.foobar", "workspace_id",
+						"data.tfe_workspace.foobar", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.tfe_workspace_state_lineage.foobar", "state_version_id"),
+					// These values rely on test-fixtures/state-versions/terraform.tfstate
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_state_lineage.foobar", "lineage", "b2b54b23-e7ea-5500-7b15-fcb68c1d92bb"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_state_lineage.foobar", "serial", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEWorkspaceStateLineageDataSourceConfig(orgName, wsName string) string {
+	return fmt.Sprintf(`
+data "tfe_workspace" "foobar" {
+  name         = "%s"
+  organization = "%s"
+}
+
+data "tfe_workspace_state_lineage" "foobar" {
+  workspace_id = data.tfe_workspace.foobar.id
+}
+`, wsName, orgName)
+}
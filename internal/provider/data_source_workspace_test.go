@@ -92,6 +92,10 @@ func TestAccTFEWorkspaceDataSource_basic(t *testing.T) {
 						"data.tfe_workspace.foobar", "queue_all_runs", "false"),
 					resource.TestCheckResourceAttr(
 						"data.tfe_workspace.foobar", "resource_count", "0"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace.foobar", "agent_pool_id", ""),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace.foobar", "last_remote_run_id", ""),
 					resource.TestCheckResourceAttr(
 						"data.tfe_workspace.foobar", "run_failures", "0"),
 					resource.TestCheckResourceAttr(
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &functionImportWorkspacesFromJSON{}
+
+// NewImportWorkspacesFromJSONFunction is a helper function to simplify the provider implementation.
+func NewImportWorkspacesFromJSONFunction() function.Function {
+	return &functionImportWorkspacesFromJSON{}
+}
+
+// functionImportWorkspacesFromJSON turns a JSON list of workspaces into the
+// IDs tfe_workspace import blocks expect. Provider functions don't get the
+// provider configuration, so it can't look workspaces up itself and falls
+// back to the <ORGANIZATION>/<WORKSPACE NAME> import format instead.
+type functionImportWorkspacesFromJSON struct{}
+
+// importWorkspace is a single entry of the JSON list.
+type importWorkspace struct {
+	Name         string `json:"name"`
+	Organization string `json:"organization"`
+	ID           string `json:"id"`
+}
+
+// Metadata returns the function name.
+func (f *functionImportWorkspacesFromJSON) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "import_workspaces_from_json"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *functionImportWorkspacesFromJSON) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build tfe_workspace import IDs from a JSON list of workspaces.",
+		Description: "Takes a JSON array of objects with a name and either an id or an organization, " +
+			"and returns a map of workspace names to the ID to import them with.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "workspaces",
+				Description: "JSON array of workspaces, such as [{\"name\": \"app\", \"organization\": \"my-org\"}].",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run parses the JSON list and returns the import ID of every workspace.
+func (f *functionImportWorkspacesFromJSON) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var raw string
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &raw)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importIDs, err := workspaceImportIDs(raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid workspaces JSON", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, importIDs)...)
+}
+
+// workspaceImportIDs maps the name of every workspace in the JSON array to
+// its ID, or to <ORGANIZATION>/<WORKSPACE NAME> when it has no ID.
+func workspaceImportIDs(raw string) (map[string]string, error) {
+	var workspaces []importWorkspace
+	if err := json.Unmarshal([]byte(raw), &workspaces); err != nil {
+		return nil, fmt.Errorf("expected a JSON array of workspaces: %w", err)
+	}
+
+	importIDs := make(map[string]string, len(workspaces))
+	for i, ws := range workspaces {
+		if ws.Name == "" {
+			return nil, fmt.Errorf("workspace %d has no name", i)
+		}
+		if _, ok := importIDs[ws.Name]; ok {
+			return nil, fmt.Errorf("workspace %q is listed more than once", ws.Name)
+		}
+
+		switch {
+		case ws.ID != "":
+			importIDs[ws.Name] = ws.ID
+		case ws.Organization != "":
+			importIDs[ws.Name] = ws.Organization + "/" + ws.Name
+		default:
+			return nil, fmt.Errorf("workspace %q needs either an id or an organization", ws.Name)
+		}
+	}
+
+	return importIDs, nil
+}
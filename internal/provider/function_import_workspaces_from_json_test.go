@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWorkspaceImportIDs(t *testing.T) {
+	tests := map[string]struct {
+		raw     string
+		want    map[string]string
+		wantErr bool
+	}{
+		"empty list": {
+			raw:  `[]`,
+			want: map[string]string{},
+		},
+		"id and organization": {
+			raw: `[
+				{"name": "app", "id": "ws-123"},
+				{"name": "db", "organization": "my-org"},
+				{"name": "web", "organization": "my-org", "id": "ws-456"}
+			]`,
+			want: map[string]string{
+				"app": "ws-123",
+				"db":  "my-org/db",
+				"web": "ws-456",
+			},
+		},
+		"not an array": {
+			raw:     `{"name": "app", "id": "ws-123"}`,
+			wantErr: true,
+		},
+		"missing name": {
+			raw:     `[{"id": "ws-123"}]`,
+			wantErr: true,
+		},
+		"missing id and organization": {
+			raw:     `[{"name": "app"}]`,
+			wantErr: true,
+		},
+		"duplicate name": {
+			raw:     `[{"name": "app", "id": "ws-123"}, {"name": "app", "id": "ws-456"}]`,
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		got, err := workspaceImportIDs(test.raw)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %v", name, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %v, want %v", name, got, test.want)
+		}
+	}
+}
@@ -103,3 +103,30 @@ func fetchOrganizationMemberByNameOrEmail(ctx context.Context, client *tfe.Clien
 
 	return nil, tfe.ErrResourceNotFound
 }
+
+func fetchOrganizationMemberByUserID(ctx context.Context, client *tfe.Client, organization, userID string) (*tfe.OrganizationMembership, error) {
+	options := &tfe.OrganizationMembershipListOptions{
+		Include: []tfe.OrgMembershipIncludeOpt{tfe.OrgMembershipUser},
+	}
+
+	for {
+		oml, err := client.OrganizationMemberships.List(ctx, organization, options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list organization memberships: %w", err)
+		}
+
+		for _, member := range oml.Items {
+			if member.User != nil && member.User.ID == userID {
+				return member, nil
+			}
+		}
+
+		if oml.CurrentPage >= oml.TotalPages {
+			break
+		}
+
+		options.PageNumber = oml.NextPage
+	}
+
+	return nil, tfe.ErrResourceNotFound
+}
@@ -126,3 +126,61 @@ func activeAndInvitedOrganizationMemberships(orgName string) []*tfe.Organization
 		},
 	}
 }
+
+func TestFetchOrganizationMemberByUserID(t *testing.T) {
+	orgName := "hashicorp"
+
+	tests := map[string]struct {
+		members              []*tfe.OrganizationMembership
+		org                  string
+		userID               string
+		err                  bool
+		expectedMembershipID string
+	}{
+		"with non exisiting organization": {
+			activeAndInvitedOrganizationMemberships(orgName),
+			"not-an-org",
+			"user-orgmember-1",
+			true,
+			"",
+		},
+		"with active member": {
+			activeAndInvitedOrganizationMemberships(orgName),
+			orgName,
+			"user-orgmember-1",
+			false,
+			"ou-orgmember-1",
+		},
+		"with invited member": {
+			activeAndInvitedOrganizationMemberships(orgName),
+			orgName,
+			"user-orgmember-2",
+			false,
+			"ou-orgmember-2",
+		},
+		"with unknown user": {
+			activeAndInvitedOrganizationMemberships(orgName),
+			orgName,
+			"user-not-a-member",
+			true,
+			"",
+		},
+	}
+
+	client := testTfeClient(t, testClientOptions{defaultOrganization: orgName})
+
+	for name, test := range tests {
+		MockOrganizationMemberships(t, client, orgName, test.members)
+		t.Run(name, func(t *testing.T) {
+			membership, err := fetchOrganizationMemberByUserID(ctx, client, test.org, test.userID)
+
+			if (err != nil) != test.err {
+				t.Fatalf("expected error is %t, got %v", test.err, err)
+			}
+
+			if membership != nil && membership.ID != test.expectedMembershipID {
+				t.Fatalf("wrong result\ngot: %s\nwant: %s", membership.ID, test.expectedMembershipID)
+			}
+		})
+	}
+}
@@ -113,7 +113,7 @@ func (p *pluginProviderServer) ValidateDataSourceConfig(ctx context.Context, req
 	if !ok {
 		return nil, errUnsupportedDataSource(req.TypeName)
 	}
-	return ds(ConfiguredClient{p.tfeClient, p.organization}).ValidateDataSourceConfig(ctx, req)
+	return ds(ConfiguredClient{Client: p.tfeClient, Organization: p.organization}).ValidateDataSourceConfig(ctx, req)
 }
 
 func (p *pluginProviderServer) ReadDataSource(ctx context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
@@ -121,7 +121,7 @@ func (p *pluginProviderServer) ReadDataSource(ctx context.Context, req *tfprotov
 	if !ok {
 		return nil, errUnsupportedDataSource(req.TypeName)
 	}
-	return ds(ConfiguredClient{p.tfeClient, p.organization}).ReadDataSource(ctx, req)
+	return ds(ConfiguredClient{Client: p.tfeClient, Organization: p.organization}).ReadDataSource(ctx, req)
 }
 
 type resourceRouter map[string]tfprotov5.ResourceServer
@@ -207,6 +207,18 @@ func PluginProviderServer() tfprotov5.ProviderServer {
 						Description: descriptions["organization"],
 						Optional:    true,
 					},
+					{
+						Name:        "default_structured_run_output_enabled",
+						Type:        tftypes.Bool,
+						Description: descriptions["default_structured_run_output_enabled"],
+						Optional:    true,
+					},
+					{
+						Name:        "workspace_locked_update_retries",
+						Type:        tftypes.Number,
+						Description: descriptions["workspace_locked_update_retries"],
+						Optional:    true,
+					},
 				},
 			},
 		},
@@ -267,6 +279,9 @@ func retrieveProviderMeta(req *tfprotov5.ConfigureProviderRequest) (providerMeta
 			"token":           tftypes.String,
 			"ssl_skip_verify": tftypes.Bool,
 			"organization":    tftypes.String,
+
+			"default_structured_run_output_enabled": tftypes.Bool,
+			"workspace_locked_update_retries":       tftypes.Number,
 		}})
 
 	if err != nil {
@@ -53,6 +53,9 @@ func TestPluginProvider_providerMeta(t *testing.T) {
 				"token":           tftypes.String,
 				"ssl_skip_verify": tftypes.Bool,
 				"organization":    tftypes.String,
+
+				"default_structured_run_output_enabled": tftypes.Bool,
+				"workspace_locked_update_retries":       tftypes.Number,
 			},
 		}, tftypes.NewValue(tftypes.Object{
 			AttributeTypes: map[string]tftypes.Type{
@@ -60,12 +63,18 @@ func TestPluginProvider_providerMeta(t *testing.T) {
 				"token":           tftypes.String,
 				"ssl_skip_verify": tftypes.Bool,
 				"organization":    tftypes.String,
+
+				"default_structured_run_output_enabled": tftypes.Bool,
+				"workspace_locked_update_retries":       tftypes.Number,
 			},
 		}, map[string]tftypes.Value{
 			"hostname":        tftypes.NewValue(tftypes.String, tc.hostname),
 			"token":           tftypes.NewValue(tftypes.String, tc.token),
 			"ssl_skip_verify": tftypes.NewValue(tftypes.Bool, tc.sslSkipVerify),
 			"organization":    tftypes.NewValue(tftypes.String, tc.organization),
+
+			"default_structured_run_output_enabled": tftypes.NewValue(tftypes.Bool, nil),
+			"workspace_locked_update_retries":       tftypes.NewValue(tftypes.Number, nil),
 		}))
 		if err != nil {
 			t.Fatal(err.Error())
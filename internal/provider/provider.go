@@ -11,21 +11,27 @@ import (
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-tfe/internal/client"
 )
 
 const defaultSSLSkipVerify = false
 
+const defaultStructuredRunOutputEnabled = true
+
 var (
 	errMissingOrganization = errors.New("no organization was specified on the resource or provider")
 )
 
 // ConfiguredClient wraps the tfe.Client the provider uses, plus the default
 // organization name to be used by resources that need an organization but don't
-// specify one.
+// specify one, the default structured run output setting for workspaces, and
+// how many times to retry updating a locked workspace.
 type ConfiguredClient struct {
-	Client       *tfe.Client
-	Organization string
+	Client                            *tfe.Client
+	Organization                      string
+	DefaultStructuredRunOutputEnabled bool
+	WorkspaceLockedUpdateRetries      int
 }
 
 func (c ConfiguredClient) schemaOrDefaultOrganization(resource *schema.ResourceData) (string, error) {
@@ -77,6 +83,19 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: descriptions["organization"],
 			},
+
+			"default_structured_run_output_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions["default_structured_run_output_enabled"],
+			},
+
+			"workspace_locked_update_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  descriptions["workspace_locked_update_retries"],
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -165,14 +184,26 @@ func configure() schema.ConfigureContextFunc {
 			providerOrganization = os.Getenv("TFE_ORGANIZATION")
 		}
 
+		structuredRunOutputEnabled := defaultStructuredRunOutputEnabled
+		if v, ok := rd.GetOkExists("default_structured_run_output_enabled"); ok {
+			structuredRunOutputEnabled = v.(bool)
+		}
+
+		lockedUpdateRetries := workspaceLockedRetryDefault
+		if v, ok := rd.GetOkExists("workspace_locked_update_retries"); ok {
+			lockedUpdateRetries = v.(int)
+		}
+
 		tfeClient, err := configureClient(rd)
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
 
 		return ConfiguredClient{
-			tfeClient,
-			providerOrganization,
+			Client:                            tfeClient,
+			Organization:                      providerOrganization,
+			DefaultStructuredRunOutputEnabled: structuredRunOutputEnabled,
+			WorkspaceLockedUpdateRetries:      lockedUpdateRetries,
 		}, nil
 	}
 }
@@ -189,9 +220,15 @@ var descriptions = map[string]string{
 	"hostname": "The Terraform Enterprise hostname to connect to. Defaults to app.terraform.io.",
 	"token": "The token used to authenticate with Terraform Enterprise. We recommend omitting\n" +
 		"the token which can be set as credentials in the CLI config file.",
-	"ssl_skip_verify": "Whether or not to skip certificate verifications.",
+	"ssl_skip_verify": "Whether or not to skip certificate verifications. WARNING: this disables\n" +
+		"TLS verification and exposes the token to man-in-the-middle attacks. Prefer\n" +
+		"adding a self-signed certificate to the system trust store instead.",
 	"organization": "The organization to apply to a resource if one is not defined on\n" +
 		"the resource itself",
+	"default_structured_run_output_enabled": "Whether workspaces that don't set structured_run_output_enabled\n" +
+		"should use the enhanced UI for run output. Defaults to true.",
+	"workspace_locked_update_retries": "How many times to retry updating a workspace while it is locked,\n" +
+		"with exponential backoff. Defaults to 5.",
 }
 
 // A commonly used helper method to check if the error
@@ -29,6 +29,22 @@ func customizeDiffIfProviderDefaultOrganizationChanged(c context.Context, diff *
 	return nil
 }
 
+func customizeDiffIfProviderDefaultStructuredRunOutputChanged(c context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(ConfiguredClient)
+
+	configValue := diff.GetRawConfig().GetAttr("structured_run_output_enabled")
+	plannedValue := diff.Get("structured_run_output_enabled").(bool)
+
+	if configValue.IsNull() && config.DefaultStructuredRunOutputEnabled != plannedValue {
+		// The workspace doesn't set structured_run_output_enabled, so it follows the
+		// provider default, which is either new or has changed.
+		if err := diff.SetNew("structured_run_output_enabled", config.DefaultStructuredRunOutputEnabled); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func modifyPlanForDefaultOrganizationChange(ctx context.Context, providerDefaultOrg string, state tfsdk.State, configAttributes, planAttributes AttrGettable, resp *resource.ModifyPlanResponse) {
 	if state.Raw.IsNull() {
 		return
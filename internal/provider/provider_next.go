@@ -9,10 +9,13 @@ import (
 	"os"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-tfe/internal/client"
 )
@@ -23,7 +26,10 @@ import (
 type frameworkProvider struct{}
 
 // Compile-time interface check
-var _ provider.Provider = &frameworkProvider{}
+var (
+	_ provider.Provider              = &frameworkProvider{}
+	_ provider.ProviderWithFunctions = &frameworkProvider{}
+)
 
 // Can be used to construct ID regexp patterns
 var base58Alphabet = "[1-9A-HJ-NP-Za-km-z]"
@@ -40,6 +46,9 @@ type FrameworkProviderConfig struct {
 	Token         types.String `tfsdk:"token"`
 	Organization  types.String `tfsdk:"organization"`
 	SSLSkipVerify types.Bool   `tfsdk:"ssl_skip_verify"`
+
+	DefaultStructuredRunOutputEnabled types.Bool  `tfsdk:"default_structured_run_output_enabled"`
+	WorkspaceLockedUpdateRetries      types.Int64 `tfsdk:"workspace_locked_update_retries"`
 }
 
 // NewFrameworkProvider is a helper function for initializing the portion of
@@ -77,6 +86,17 @@ func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Description: descriptions["ssl_skip_verify"],
 				Optional:    true,
 			},
+			"default_structured_run_output_enabled": schema.BoolAttribute{
+				Description: descriptions["default_structured_run_output_enabled"],
+				Optional:    true,
+			},
+			"workspace_locked_update_retries": schema.Int64Attribute{
+				Description: descriptions["workspace_locked_update_retries"],
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 		},
 	}
 }
@@ -105,6 +125,16 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 		data.Organization = types.StringValue(os.Getenv("TFE_ORGANIZATION"))
 	}
 
+	structuredRunOutputEnabled := defaultStructuredRunOutputEnabled
+	if !data.DefaultStructuredRunOutputEnabled.IsNull() && !data.DefaultStructuredRunOutputEnabled.IsUnknown() {
+		structuredRunOutputEnabled = data.DefaultStructuredRunOutputEnabled.ValueBool()
+	}
+
+	lockedUpdateRetries := workspaceLockedRetryDefault
+	if !data.WorkspaceLockedUpdateRetries.IsNull() && !data.WorkspaceLockedUpdateRetries.IsUnknown() {
+		lockedUpdateRetries = int(data.WorkspaceLockedUpdateRetries.ValueInt64())
+	}
+
 	tfeClient, err := client.GetClient(data.Hostname.ValueString(), data.Token.ValueString(), data.SSLSkipVerify.ValueBool())
 
 	if err != nil {
@@ -113,8 +143,10 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 	}
 
 	configuredClient := ConfiguredClient{
-		Client:       tfeClient,
-		Organization: data.Organization.ValueString(),
+		Client:                            tfeClient,
+		Organization:                      data.Organization.ValueString(),
+		DefaultStructuredRunOutputEnabled: structuredRunOutputEnabled,
+		WorkspaceLockedUpdateRetries:      lockedUpdateRetries,
 	}
 
 	res.DataSourceData = configuredClient
@@ -123,11 +155,20 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 
 func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAdminSettingsCostEstimationDataSource,
 		NewRegistryGPGKeyDataSource,
 		NewRegistryGPGKeysDataSource,
+		NewRegistryModuleDataSource,
 		NewRegistryProviderDataSource,
 		NewRegistryProvidersDataSource,
 		NewSAMLSettingsDataSource,
+		NewVariableSetVariableDataSource,
+		NewWorkspaceCountDataSource,
+		NewWorkspaceEffectiveSettingsDataSource,
+		NewWorkspaceNotificationsDataSource,
+		NewWorkspaceProjectsDataSource,
+		NewWorkspaceRunTriggersDataSource,
+		NewWorkspaceStateLineageDataSource,
 	}
 }
 
@@ -137,6 +178,15 @@ func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Res
 		NewRegistryProviderResource,
 		NewResourceVariable,
 		NewSAMLSettingsResource,
+		NewUserTokenResource,
+		NewWorkspaceOperationWaitResource,
+		NewWorkspaceRunTaskBulkAssignmentResource,
 		NewResourceWorkspaceSettings,
 	}
 }
+
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewImportWorkspacesFromJSONFunction,
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-tfe/internal/client"
@@ -56,8 +57,10 @@ func providerWithDefaultOrganization(defaultOrgName string) map[string]*schema.P
 	testAccProviderDefaultOrganization.ConfigureContextFunc = func(ctx context.Context, rd *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		client, err := getClientUsingEnv()
 		return ConfiguredClient{
-			Client:       client,
-			Organization: defaultOrgName,
+			Client:                            client,
+			Organization:                      defaultOrgName,
+			DefaultStructuredRunOutputEnabled: defaultStructuredRunOutputEnabled,
+			WorkspaceLockedUpdateRetries:      workspaceLockedRetryDefault,
 		}, diag.FromErr(err)
 	}
 	return map[string]*schema.Provider{
@@ -210,6 +213,27 @@ func TestConfigureEnvOrganization(t *testing.T) {
 	}
 }
 
+func TestAccTFEProvider_aliasedOrganizations(t *testing.T) {
+	defaultOrgName, rInt := setupDefaultOrganization(t)
+	aliasedOrgName, _ := setupDefaultOrganization(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEProvider_aliasedOrganizations(rInt, defaultOrgName, aliasedOrgName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.default", "organization", defaultOrgName),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.aliased", "organization", aliasedOrgName),
+				),
+			},
+		},
+	})
+}
+
 // The TFE Provider tests use these environment variables, which are set in the
 // GitHub Action workflow file .github/workflows/ci.yml.
 func testAccGithubPreCheck(t *testing.T) {
@@ -256,3 +280,24 @@ var envGithubWorkspaceIdentifier string
 var envGithubWorkspaceBranch string
 var envTFEUser1 string
 var envTFEUser2 string
+
+func testAccTFEProvider_aliasedOrganizations(rInt int, defaultOrgName, aliasedOrgName string) string {
+	return fmt.Sprintf(`
+provider "tfe" {
+  organization = "%s"
+}
+
+provider "tfe" {
+  alias        = "other"
+  organization = "%s"
+}
+
+resource "tfe_workspace" "default" {
+  name = "workspace-test-%d"
+}
+
+resource "tfe_workspace" "aliased" {
+  provider = tfe.other
+  name     = "workspace-test-%d"
+}`, defaultOrgName, aliasedOrgName, rInt, rInt)
+}
@@ -150,7 +150,7 @@ func resourceTFEAgentPoolImporter(ctx context.Context, d *schema.ResourceData, m
 		pool, err := fetchAgentPool(org, poolName, config.Client)
 		if err != nil {
 			return nil, fmt.Errorf(
-				"error retrieving agent pool with name %s from organization %s %w", poolName, org, err)
+				"error retrieving agent pool with name %s from organization %s: %w", poolName, org, err)
 		}
 
 		d.SetId(pool.ID)
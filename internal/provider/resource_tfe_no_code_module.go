@@ -142,14 +142,6 @@ func variableOptionsMaptoStruct(variableOptions []interface{}) []*tfe.NoCodeVari
 func resourceTFENoCodeModuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
-	readOpts := &tfe.RegistryNoCodeModuleReadOptions{
-		Include: []tfe.RegistryNoCodeModuleIncludeOpt{tfe.RegistryNoCodeIncludeVariableOptions},
-	}
-	noCodeModule, err := config.Client.RegistryNoCodeModules.Read(ctx, d.Id(), readOpts)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	options := tfe.RegistryNoCodeModuleUpdateOptions{
 		Enabled:        tfe.Bool(d.Get("enabled").(bool)),
 		RegistryModule: &tfe.RegistryModule{ID: d.Get("registry_module").(string)},
@@ -162,7 +154,9 @@ func resourceTFENoCodeModuleUpdate(ctx context.Context, d *schema.ResourceData,
 		options.VariableOptions = variableOptionsMaptoStruct(variableOptions.([]interface{}))
 	}
 
-	err = retry.RetryContext(ctx, time.Duration(5)*time.Minute, func() *retry.RetryError {
+	var noCodeModule *tfe.RegistryNoCodeModule
+	err := retry.RetryContext(ctx, time.Duration(5)*time.Minute, func() *retry.RetryError {
+		var err error
 		noCodeModule, err = config.Client.RegistryNoCodeModules.Update(ctx, d.Id(), options)
 		if err != nil {
 			return retry.RetryableError(err)
@@ -171,7 +165,8 @@ func resourceTFENoCodeModuleUpdate(ctx context.Context, d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return diag.Errorf("Error while waiting for no-code module %s to be updated: %s", noCodeModule.ID, err)
+		// noCodeModule is nil when every attempt failed, so use the ID from state.
+		return diag.Errorf("Error while waiting for no-code module %s to be updated: %s", d.Id(), err)
 	}
 
 	d.SetId(noCodeModule.ID)
@@ -9,9 +9,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/url"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -27,6 +31,12 @@ func resourceTFENotificationConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: func(c context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			warnEmptyNotificationTriggers(d)
+
+			return validateMicrosoftTeamsWebhookURLDiff(c, d, meta)
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -341,6 +351,27 @@ func resourceTFENotificationConfigurationDelete(d *schema.ResourceData, meta int
 }
 
 // Custom CustomizeDiff functions and helpers
+
+// warnEmptyNotificationTriggers logs a warning when triggers is explicitly
+// configured as an empty set. An empty set is sent the same way as leaving
+// triggers unset, so the notification configuration gets no triggers.
+func warnEmptyNotificationTriggers(d *schema.ResourceDiff) {
+	if hasEmptyNotificationTriggers(d.GetRawConfig()) {
+		log.Printf("[WARN] Notification configuration %q sets triggers to an empty set, so it won't send any notifications", d.Get("name").(string))
+	}
+}
+
+// hasEmptyNotificationTriggers reports whether the given raw configuration
+// sets triggers to an empty set, as opposed to leaving it unset.
+func hasEmptyNotificationTriggers(config cty.Value) bool {
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+
+	triggers := config.GetAttr("triggers")
+	return !triggers.IsNull() && triggers.IsKnown() && triggers.LengthInt() == 0
+}
+
 func validateSchemaAttributesForDestinationTypeEmail(d *schema.ResourceData) error {
 	// Make sure url and token are not set when destination_type is 'email'
 	_, urlIsSet := d.GetOk("url")
@@ -422,3 +453,60 @@ func validateSchemaAttributesForDestinationTypeMicrosoftTeams(d *schema.Resource
 
 	return nil
 }
+
+// validateMicrosoftTeamsWebhookURLDiff checks the url of a microsoft-teams
+// notification configuration at plan time, once both destination_type and
+// url are known.
+func validateMicrosoftTeamsWebhookURLDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("destination_type") || !d.NewValueKnown("url") {
+		return nil
+	}
+
+	if tfe.NotificationDestinationType(d.Get("destination_type").(string)) != tfe.NotificationDestinationTypeMicrosoftTeams {
+		return nil
+	}
+
+	// A missing url is reported by the destination type checks on apply.
+	webhookURL := d.Get("url").(string)
+	if webhookURL == "" {
+		return nil
+	}
+
+	return validateMicrosoftTeamsWebhookURL(webhookURL)
+}
+
+// validateMicrosoftTeamsWebhookURL checks that the given URL looks like a
+// Microsoft Teams incoming webhook or Teams Workflows URL. Incoming webhooks
+// use the legacy https://outlook.office.com/webhook/ prefix or a tenant
+// specific https://<tenant>.webhook.office.com/ host. Workflows are served
+// from Azure Logic Apps, https://<region>.logic.azure.com/workflows/, or from
+// Power Platform environments, https://<environment>.powerplatform.com/. The
+// API only returns a generic error for malformed webhook URLs, so we catch the
+// common mistakes here instead.
+//
+// Plain HTTP URLs were accepted before this check was added, so they are only
+// logged as deprecated for now.
+func validateMicrosoftTeamsWebhookURL(webhookURL string) error {
+	u, err := url.Parse(webhookURL)
+	if err == nil && u.Scheme == "http" && u.Host != "" {
+		log.Printf("[WARN] Microsoft Teams notification URL %q uses HTTP, which is deprecated and will be rejected in a future release", webhookURL)
+		return nil
+	}
+	if err != nil || u.Scheme != "https" {
+		return fmt.Errorf("URL must be a Microsoft Teams webhook URL starting with https://outlook.office.com/webhook/ or a Teams Workflows URL, got: %q", webhookURL)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	switch {
+	case host == "outlook.office.com" && strings.HasPrefix(u.Path, "/webhook/"):
+		return nil
+	case strings.HasSuffix(host, ".webhook.office.com"):
+		return nil
+	case strings.HasSuffix(host, ".logic.azure.com") && strings.HasPrefix(u.Path, "/workflows/"):
+		return nil
+	case strings.HasSuffix(host, ".powerplatform.com"):
+		return nil
+	}
+
+	return fmt.Errorf("URL must be a Microsoft Teams webhook URL starting with https://outlook.office.com/webhook/ or a Teams Workflows URL, got: %q", webhookURL)
+}
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -446,6 +447,10 @@ func TestAccTFENotificationConfiguration_updateValidateSchemaAttributesMicrosoft
 				Config:      testAccTFENotificationConfiguration_microsoftTeamsWithoutURL(rInt),
 				ExpectError: regexp.MustCompile(`URL is required with destination type of microsoft-teams`),
 			},
+			{
+				Config:      testAccTFENotificationConfiguration_microsoftTeamsWithInvalidURL(rInt),
+				ExpectError: regexp.MustCompile(`URL must be a Microsoft Teams webhook URL`),
+			},
 		},
 	})
 }
@@ -1193,6 +1198,26 @@ resource "tfe_notification_configuration" "foobar" {
 }`, rInt)
 }
 
+func testAccTFENotificationConfiguration_microsoftTeamsWithInvalidURL(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_notification_configuration" "foobar" {
+  name             = "notification_msteams_with_invalid_url"
+  destination_type = "microsoft-teams"
+  url              = "https://example.com/webhook/"
+  workspace_id     = tfe_workspace.foobar.id
+}`, rInt)
+}
+
 func testAccTFENotificationConfiguration_duplicateTriggers(rInt int) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
@@ -1213,3 +1238,46 @@ resource "tfe_notification_configuration" "foobar" {
   workspace_id     = tfe_workspace.foobar.id
 }`, rInt)
 }
+
+func TestHasEmptyNotificationTriggers(t *testing.T) {
+	cases := map[string]struct {
+		triggers cty.Value
+		want     bool
+	}{
+		"unset":      {triggers: cty.NullVal(cty.Set(cty.String)), want: false},
+		"empty":      {triggers: cty.SetValEmpty(cty.String), want: true},
+		"configured": {triggers: cty.SetVal([]cty.Value{cty.StringVal("run:created")}), want: false},
+		"unknown":    {triggers: cty.UnknownVal(cty.Set(cty.String)), want: false},
+	}
+
+	for name, c := range cases {
+		config := cty.ObjectVal(map[string]cty.Value{"triggers": c.triggers})
+		if got := hasEmptyNotificationTriggers(config); got != c.want {
+			t.Errorf("%s: expected %t, got %t", name, c.want, got)
+		}
+	}
+}
+
+func TestValidateMicrosoftTeamsWebhookURL(t *testing.T) {
+	cases := map[string]bool{
+		"https://outlook.office.com/webhook/00000000-0000-0000-0000-000000000000":                  true,
+		"https://contoso.webhook.office.com/webhookb2/00000000-0000-0000-0000-0000":                true,
+		"https://prod-00.westus.logic.azure.com:443/workflows/0000/triggers/manual/paths/invoke":   true,
+		"https://default0000.00.environment.api.powerplatform.com:443/powerautomate/automations/0": true,
+		"http://example.com": true,
+		"https://outlook.office.com/hook/00000000-0000-0000-0000-000000000000": false,
+		"https://prod-00.westus.logic.azure.com/hooks/0000":                    false,
+		"https://example.com/webhook/":                                         false,
+		"not a url":                                                            false,
+	}
+
+	for webhookURL, valid := range cases {
+		err := validateMicrosoftTeamsWebhookURL(webhookURL)
+		if valid && err != nil {
+			t.Errorf("expected %q to be valid, got: %v", webhookURL, err)
+		}
+		if !valid && err == nil {
+			t.Errorf("expected %q to be invalid", webhookURL)
+		}
+	}
+}
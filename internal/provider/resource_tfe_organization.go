@@ -96,6 +96,11 @@ func resourceTFEOrganization() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"repository_integration_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -155,6 +160,20 @@ func resourceTFEOrganizationRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("default_project_id", org.DefaultProject.ID)
 	}
 
+	// The organization API doesn't report whether VCS integration is set up,
+	// so this is a heuristic: check whether the organization has at least one
+	// OAuth client. Connections made only through the GitHub App aren't
+	// counted. Listing OAuth clients needs permission to manage VCS settings,
+	// so keep the previous value instead of failing the read without it.
+	oauthClients, err := config.Client.OAuthClients.List(ctx, org.Name, &tfe.OAuthClientListOptions{
+		ListOptions: tfe.ListOptions{PageSize: 1},
+	})
+	if err != nil {
+		log.Printf("[WARN] Error reading OAuth clients of organization %s, leaving repository_integration_enabled unchanged: %v", org.Name, err)
+	} else {
+		d.Set("repository_integration_enabled", len(oauthClients.Items) > 0)
+	}
+
 	return nil
 }
 
@@ -193,7 +212,7 @@ func resourceTFEOrganizationUpdate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	// If send_passing_statuses_for_untriggered_speculative_plans is supplied, set it using the options struct.
-	if sendPassingStatusesForUntriggeredSpeculativePlans, ok := d.GetOk("send_passing_statuses_for_untriggered_speculative_plans"); ok {
+	if sendPassingStatusesForUntriggeredSpeculativePlans, ok := d.GetOkExists("send_passing_statuses_for_untriggered_speculative_plans"); ok {
 		options.SendPassingStatusesForUntriggeredSpeculativePlans = tfe.Bool(sendPassingStatusesForUntriggeredSpeculativePlans.(bool))
 	}
 
@@ -23,6 +23,7 @@ func resourceTFEOrganizationDefaultSettings() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTFEOrganizationDefaultSettingsCreate,
 		Read:   resourceTFEOrganizationDefaultSettingsRead,
+		Update: resourceTFEOrganizationDefaultSettingsUpdate,
 		Delete: resourceTFEOrganizationDefaultSettingsDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEOrganizationDefaultSettingsImporter,
@@ -49,13 +50,11 @@ func resourceTFEOrganizationDefaultSettings() *schema.Resource {
 					},
 					false,
 				),
-				ForceNew: true,
 			},
 
 			"default_agent_pool_id": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 		},
 	}
@@ -70,6 +69,30 @@ func resourceTFEOrganizationDefaultSettingsCreate(d *schema.ResourceData, meta i
 		return fmt.Errorf("error getting organization name: %w", err)
 	}
 
+	err = updateOrganizationDefaultSettings(d, config, organization)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(organization)
+
+	return resourceTFEOrganizationDefaultSettingsRead(d, meta)
+}
+
+func resourceTFEOrganizationDefaultSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(ConfiguredClient)
+
+	err := updateOrganizationDefaultSettings(d, config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	return resourceTFEOrganizationDefaultSettingsRead(d, meta)
+}
+
+// updateOrganizationDefaultSettings sets the default execution mode and agent
+// pool of the organization to the configured values.
+func updateOrganizationDefaultSettings(d *schema.ResourceData, config ConfiguredClient, organization string) error {
 	// If the "default_agent_pool_id" was provided, get the agent pool
 	var agentPool *tfe.AgentPool
 	if v, ok := d.GetOk("default_agent_pool_id"); ok && v.(string) != "" {
@@ -85,18 +108,16 @@ func resourceTFEOrganizationDefaultSettingsCreate(d *schema.ResourceData, meta i
 		return fmt.Errorf("default_execution_mode was missing from tfstate, please create an issue to report this error")
 	}
 
-	// set organization default execution mode
-	_, err = config.Client.Organizations.Update(context.Background(), organization, tfe.OrganizationUpdateOptions{
+	log.Printf("[DEBUG] Update default settings of organization: %s", organization)
+	_, err := config.Client.Organizations.Update(ctx, organization, tfe.OrganizationUpdateOptions{
 		DefaultExecutionMode: tfe.String(defaultExecutionMode),
 		DefaultAgentPool:     agentPool,
 	})
 	if err != nil {
-		return fmt.Errorf("error setting default execution mode of organization %s: %w", d.Id(), err)
+		return fmt.Errorf("error setting default execution mode of organization %s: %w", organization, err)
 	}
 
-	d.SetId(organization)
-
-	return resourceTFEOrganizationDefaultSettingsRead(d, meta)
+	return nil
 }
 
 func resourceTFEOrganizationDefaultSettingsRead(d *schema.ResourceData, meta interface{}) error {
@@ -113,16 +134,15 @@ func resourceTFEOrganizationDefaultSettingsRead(d *schema.ResourceData, meta int
 		return fmt.Errorf("error reading organization %s: %w", d.Id(), err)
 	}
 
-	defaultExecutionMode := ""
-	if v, ok := d.GetOk("default_execution_mode"); ok {
-		defaultExecutionMode = v.(string)
-	} else {
-		return fmt.Errorf("default_execution_mode was missing from tfstate, please create an issue to report this error")
-	}
-	if organization.DefaultExecutionMode != defaultExecutionMode {
-		// set id to empty string so that the provider knows it needs to set the default execution mode again
-		d.SetId("")
+	// Settings changed outside of Terraform show up as an in-place update.
+	d.Set("organization", organization.Name)
+	d.Set("default_execution_mode", organization.DefaultExecutionMode)
+
+	var agentPoolID string
+	if organization.DefaultAgentPool != nil {
+		agentPoolID = organization.DefaultAgentPool.ID
 	}
+	d.Set("default_agent_pool_id", agentPoolID)
 
 	return nil
 }
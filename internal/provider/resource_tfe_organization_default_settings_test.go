@@ -104,6 +104,9 @@ func TestAccTFEOrganizationDefaultSettings_update(t *testing.T) {
 						"tfe_organization.foobar", org),
 					testAccCheckTFEOrganizationDefaultSettings(org, "agent"),
 					testAccCheckTFEOrganizationDefaultAgentPoolIDExists(org),
+					resource.TestCheckResourceAttrPair(
+						"tfe_organization_default_settings.foobar", "default_agent_pool_id",
+						"tfe_agent_pool.foobar", "id"),
 				),
 			},
 			{
@@ -112,6 +115,8 @@ func TestAccTFEOrganizationDefaultSettings_update(t *testing.T) {
 					testAccCheckTFEOrganizationExists(
 						"tfe_organization.foobar", org),
 					testAccCheckTFEOrganizationDefaultSettings(org, "local"),
+					resource.TestCheckResourceAttr(
+						"tfe_organization_default_settings.foobar", "default_agent_pool_id", ""),
 				),
 			},
 			{
@@ -52,6 +52,11 @@ func resourceTFEOrganizationMembership() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -106,6 +111,7 @@ func resourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interfac
 	d.Set("organization", membership.Organization.Name)
 	d.Set("user_id", membership.User.ID)
 	d.Set("username", membership.User.Username)
+	d.Set("status", string(membership.Status))
 
 	return nil
 }
@@ -131,9 +137,23 @@ func resourceTFEOrganizationMembershipImporter(ctx context.Context, d *schema.Re
 	// Import formats:
 	//  - <ORGANIZATION MEMBERSHIP ID>
 	//  - <organization name>/<user email>
+	//  - <organization name>/<user ID>
 	s := strings.SplitN(d.Id(), "/", 2)
 	if len(s) == 2 {
 		org := s[0]
+
+		if isResourceIDFormat("user", s[1]) {
+			userID := s[1]
+			orgMembership, err := fetchOrganizationMemberByUserID(ctx, config.Client, org, userID)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"error retrieving user with ID %s from organization %s: %w", userID, org, err)
+			}
+
+			d.SetId(orgMembership.ID)
+			return []*schema.ResourceData{d}, nil
+		}
+
 		email := s[1]
 		orgMembership, err := fetchOrganizationMemberByNameOrEmail(ctx, config.Client, org, "", email)
 		if err != nil {
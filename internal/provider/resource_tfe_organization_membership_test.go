@@ -38,6 +38,8 @@ func TestAccTFEOrganizationMembership_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tfe_organization_membership.foobar", "user_id"),
 					resource.TestCheckResourceAttr(
 						"tfe_organization_membership.foobar", "username", ""),
+					resource.TestCheckResourceAttr(
+						"tfe_organization_membership.foobar", "status", "invited"),
 				),
 			},
 		},
@@ -88,6 +90,30 @@ func TestAccTFEOrganizationMembershipImport_ByEmail(t *testing.T) {
 	})
 }
 
+func TestAccTFEOrganizationMembershipImport_ByUserID(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	orgName := fmt.Sprintf("tst-terraform-%d", rInt)
+	email := "testuser@hashicorp.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEOrganizationMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEOrganizationMembership_nameAndEmail(orgName, email),
+			},
+			{
+				ResourceName:      "tfe_organization_membership.foobar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccTFEOrganizationMembershipImportStateIDByUserID("tfe_organization_membership.foobar"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccTFEOrganizationMembershipImport_invalidImportId(t *testing.T) {
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
 
@@ -174,6 +200,17 @@ func testAccCheckTFEOrganizationMembershipAttributes(
 	}
 }
 
+func testAccTFEOrganizationMembershipImportStateIDByUserID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["organization"], rs.Primary.Attributes["user_id"]), nil
+	}
+}
+
 func testAccCheckTFEOrganizationMembershipDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(ConfiguredClient)
 
@@ -54,6 +54,8 @@ func resourceTFEOrganizationRunTask() *schema.Resource {
 				Type:     schema.TypeString,
 				Default:  "task",
 				Optional: true,
+				// Run tasks are the only category the API currently supports.
+				ValidateFunc: validation.StringInSlice([]string{"task"}, false),
 			},
 
 			"hmac_key": {
@@ -176,6 +178,9 @@ func resourceTFEOrganizationRunTaskRead(d *schema.ResourceData, meta interface{}
 
 	// Update the config.
 	d.Set("name", task.Name)
+	if task.Organization != nil {
+		d.Set("organization", task.Organization.Name)
+	}
 	d.Set("url", task.URL)
 	d.Set("category", task.Category)
 	d.Set("enabled", task.Enabled)
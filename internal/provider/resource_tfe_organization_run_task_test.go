@@ -36,6 +36,19 @@ func TestAccTFEOrganizationRunTask_validateSchemaAttributeUrl(t *testing.T) {
 	})
 }
 
+func TestAccTFEOrganizationRunTask_validateSchemaAttributeCategory(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFEOrganizationRunTask_category("org", 1, "https://a.valid.url/path", "hook"),
+				ExpectError: regexp.MustCompile(`expected category to be one of \["task"\]`),
+			},
+		},
+	})
+}
+
 func TestAccTFEOrganizationRunTask_create(t *testing.T) {
 	skipUnlessRunTasksDefined(t)
 
@@ -184,3 +197,14 @@ func testAccTFEOrganizationRunTask_update(orgName string, rInt int, runTaskURL s
 	}
 `, orgName, runTaskURL, rInt)
 }
+
+func testAccTFEOrganizationRunTask_category(orgName string, rInt int, runTaskURL, category string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization_run_task" "foobar" {
+  organization = "%s"
+  url          = "%s"
+  name         = "foobar-task-%d"
+  category     = "%s"
+}
+`, orgName, runTaskURL, rInt, category)
+}
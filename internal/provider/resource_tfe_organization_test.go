@@ -38,6 +38,8 @@ func TestAccTFEOrganization_basic(t *testing.T) {
 						"tfe_organization.foobar", "email", "admin@company.com"),
 					resource.TestCheckResourceAttr(
 						"tfe_organization.foobar", "collaborator_auth_policy", "password"),
+					resource.TestCheckResourceAttr(
+						"tfe_organization.foobar", "repository_integration_enabled", "false"),
 				),
 			},
 		},
@@ -112,6 +114,41 @@ func TestAccTFEOrganization_defaultProject(t *testing.T) {
 	})
 }
 
+func TestAccTFEOrganization_sendPassingStatuses(t *testing.T) {
+	skipIfEnterprise(t)
+
+	org := &tfe.Organization{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEOrganizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEOrganization_sendPassingStatuses(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEOrganizationExists(
+						"tfe_organization.foobar", org),
+					testAccCheckTFEOrganizationSendPassingStatuses(org, true),
+					resource.TestCheckResourceAttr(
+						"tfe_organization.foobar", "send_passing_statuses_for_untriggered_speculative_plans", "true"),
+				),
+			},
+			{
+				Config: testAccTFEOrganization_sendPassingStatuses(rInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEOrganizationExists(
+						"tfe_organization.foobar", org),
+					testAccCheckTFEOrganizationSendPassingStatuses(org, false),
+					resource.TestCheckResourceAttr(
+						"tfe_organization.foobar", "send_passing_statuses_for_untriggered_speculative_plans", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFEOrganization_update_costEstimation(t *testing.T) {
 	t.Skip("Skipping this test until the SDK can support importing resources before applying a configuration")
 
@@ -144,7 +181,7 @@ func TestAccTFEOrganization_update_costEstimation(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTFEOrganizationExists(
 						"tfe_organization.foobar", org),
-					testAccCheckTFEOrganizationAttributesUpdated(org, org.Name, costEstimationEnabled1),
+					testAccCheckTFEOrganizationAttributesUpdated(org, org.Name, costEstimationEnabled1, allowForceDeleteWorkspaces1),
 					resource.TestCheckResourceAttr(
 						"tfe_organization.foobar", "name", org.Name),
 					resource.TestCheckResourceAttr(
@@ -173,7 +210,7 @@ func TestAccTFEOrganization_update_costEstimation(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTFEOrganizationExists(
 						"tfe_organization.foobar", org),
-					testAccCheckTFEOrganizationAttributesUpdated(org, updatedName, costEstimationEnabled2),
+					testAccCheckTFEOrganizationAttributesUpdated(org, updatedName, costEstimationEnabled2, allowForceDeleteWorkspaces2),
 					resource.TestCheckResourceAttr(
 						"tfe_organization.foobar", "name", updatedName),
 					resource.TestCheckResourceAttr(
@@ -330,12 +367,16 @@ func testAccCheckTFEOrganizationAttributesFull(
 			return fmt.Errorf("Bad cost-estimation-enabled: %t", org.CostEstimationEnabled)
 		}
 
+		if org.AllowForceDeleteWorkspaces != false {
+			return fmt.Errorf("Bad allow-force-delete-workspaces: %t", org.AllowForceDeleteWorkspaces)
+		}
+
 		return nil
 	}
 }
 
 func testAccCheckTFEOrganizationAttributesUpdated(
-	org *tfe.Organization, expectedOrgName string, expectedCostEstimationEnabled bool) resource.TestCheckFunc {
+	org *tfe.Organization, expectedOrgName string, expectedCostEstimationEnabled, expectedAllowForceDeleteWorkspaces bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if org.Name != expectedOrgName {
 			return fmt.Errorf("Bad name: %s", org.Name)
@@ -365,6 +406,20 @@ func testAccCheckTFEOrganizationAttributesUpdated(
 			return fmt.Errorf("Bad cost-estimation-enabled: %t", org.CostEstimationEnabled)
 		}
 
+		if org.AllowForceDeleteWorkspaces != expectedAllowForceDeleteWorkspaces {
+			return fmt.Errorf("Bad allow-force-delete-workspaces: %t", org.AllowForceDeleteWorkspaces)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTFEOrganizationSendPassingStatuses(org *tfe.Organization, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if org.SendPassingStatusesForUntriggeredSpeculativePlans != expected {
+			return fmt.Errorf("Bad send-passing-statuses-for-untriggered-speculative-plans: %t", org.SendPassingStatusesForUntriggeredSpeculativePlans)
+		}
+
 		return nil
 	}
 }
@@ -434,3 +489,12 @@ resource "tfe_organization" "foobar" {
   allow_force_delete_workspaces     = %t
 }`, orgName, orgEmail, costEstimationEnabled, assessmentsEnforced, allowForceDeleteWorkspaces)
 }
+
+func testAccTFEOrganization_sendPassingStatuses(rInt int, sendPassingStatuses bool) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name                                                    = "tst-terraform-%d"
+  email                                                   = "admin@company.com"
+  send_passing_statuses_for_untriggered_speculative_plans = %t
+}`, rInt, sendPassingStatuses)
+}
@@ -16,14 +16,17 @@ import (
 	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceTFEOrganizationToken() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEOrganizationTokenCreate,
-		Read:   resourceTFEOrganizationTokenRead,
-		Delete: resourceTFEOrganizationTokenDelete,
+		CreateContext: resourceTFEOrganizationTokenCreate,
+		ReadContext:   resourceTFEOrganizationTokenRead,
+		UpdateContext: resourceTFEOrganizationTokenUpdate,
+		Delete:        resourceTFEOrganizationTokenDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEOrganizationTokenImporter,
 		},
@@ -55,29 +58,35 @@ func resourceTFEOrganizationToken() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+
+			"renewal_window_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 		},
 	}
 }
 
-func resourceTFEOrganizationTokenCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the organization name.
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Check if a token already exists for organization: %s", organization)
 	_, err = config.Client.OrganizationTokens.Read(ctx, organization)
 	if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
-		return fmt.Errorf("error checking if a token exists for organization %s: %w", organization, err)
+		return diag.Errorf("error checking if a token exists for organization %s: %v", organization, err)
 	}
 
 	// If error is nil, the token already exists.
 	if err == nil {
 		if !d.Get("force_regenerate").(bool) {
-			return fmt.Errorf("a token already exists for organization: %s", organization)
+			return diag.Errorf("a token already exists for organization: %s", organization)
 		}
 		log.Printf("[DEBUG] Regenerating existing token for organization: %s", organization)
 	}
@@ -95,14 +104,14 @@ func resourceTFEOrganizationTokenCreate(d *schema.ResourceData, meta interface{}
 		options.ExpiredAt = &expiry
 
 		if err != nil {
-			return fmt.Errorf("%s must be a valid date or time, provided in iso8601 format", expiredAt)
+			return diag.Errorf("%s must be a valid date or time, provided in iso8601 format", expiredAt)
 		}
 	}
 
 	token, err := config.Client.OrganizationTokens.CreateWithOptions(ctx, organization, options)
 	if err != nil {
-		return fmt.Errorf(
-			"error creating new token for organization %s: %w", organization, err)
+		return diag.Errorf(
+			"error creating new token for organization %s: %v", organization, err)
 	}
 
 	d.SetId(organization)
@@ -111,24 +120,59 @@ func resourceTFEOrganizationTokenCreate(d *schema.ResourceData, meta interface{}
 	// only be returned once during the creation of the token.
 	d.Set("token", token.Token)
 
-	return resourceTFEOrganizationTokenRead(d, meta)
+	return resourceTFEOrganizationTokenRead(ctx, d, meta)
 }
 
-func resourceTFEOrganizationTokenRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read the token from organization: %s", d.Id())
-	_, err := config.Client.OrganizationTokens.Read(ctx, d.Id())
+	token, err := config.Client.OrganizationTokens.Read(ctx, d.Id())
 	if err != nil {
 		if err == tfe.ErrResourceNotFound {
 			log.Printf("[DEBUG] Token for organization %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading token from organization %s: %w", d.Id(), err)
+		return diag.Errorf("error reading token from organization %s: %v", d.Id(), err)
 	}
 
-	return nil
+	return tokenExpiryDiagnostics(
+		fmt.Sprintf("organization %s", d.Id()), token.ExpiredAt, d.Get("renewal_window_days").(int), time.Now())
+}
+
+// resourceTFEOrganizationTokenUpdate only runs when renewal_window_days
+// changes, which is not sent to the API.
+func resourceTFEOrganizationTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceTFEOrganizationTokenRead(ctx, d, meta)
+}
+
+// tokenExpiryDiagnostics warns when a token expires within the renewal
+// window, so the token can be rotated before whatever uses it breaks. A
+// renewal window of 0 or a token without an expiry never warns.
+func tokenExpiryDiagnostics(owner string, expiredAt time.Time, renewalWindowDays int, now time.Time) diag.Diagnostics {
+	if renewalWindowDays <= 0 || expiredAt.IsZero() {
+		return nil
+	}
+
+	renewalWindow := time.Duration(renewalWindowDays) * 24 * time.Hour
+	if expiredAt.Sub(now) > renewalWindow {
+		return nil
+	}
+
+	summary := "Token expires soon"
+	if !expiredAt.After(now) {
+		summary = "Token has expired"
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  summary,
+		Detail: fmt.Sprintf(
+			"The token of %s expires at %s, within the renewal window of %d days. "+
+				"Rotate it by changing expired_at or setting force_regenerate before it expires.",
+			owner, expiredAt.Format(time.RFC3339), renewalWindowDays),
+	}}
 }
 
 func resourceTFEOrganizationTokenDelete(d *schema.ResourceData, meta interface{}) error {
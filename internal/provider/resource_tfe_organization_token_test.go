@@ -11,10 +11,57 @@ import (
 	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestTokenExpiryDiagnostics(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		expiredAt         time.Time
+		renewalWindowDays int
+		summary           string
+	}{
+		"no renewal window": {
+			expiredAt: now.Add(time.Hour),
+		},
+		"no expiry": {
+			renewalWindowDays: 30,
+		},
+		"outside renewal window": {
+			expiredAt:         now.AddDate(0, 0, 31),
+			renewalWindowDays: 30,
+		},
+		"inside renewal window": {
+			expiredAt:         now.AddDate(0, 0, 29),
+			renewalWindowDays: 30,
+			summary:           "Token expires soon",
+		},
+		"expired": {
+			expiredAt:         now.AddDate(0, 0, -1),
+			renewalWindowDays: 30,
+			summary:           "Token has expired",
+		},
+	}
+
+	for name, tc := range cases {
+		diags := tokenExpiryDiagnostics("organization tst-organization", tc.expiredAt, tc.renewalWindowDays, now)
+
+		if tc.summary == "" {
+			if len(diags) != 0 {
+				t.Fatalf("%s: expected no diagnostics, got %v", name, diags)
+			}
+			continue
+		}
+
+		if len(diags) != 1 || diags[0].Severity != diag.Warning || diags[0].Summary != tc.summary {
+			t.Fatalf("%s: expected a %q warning, got %v", name, tc.summary, diags)
+		}
+	}
+}
+
 func TestAccTFEOrganizationToken_basic(t *testing.T) {
 	token := &tfe.OrganizationToken{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -10,9 +10,12 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 
 	"github.com/hashicorp/go-tfe"
@@ -31,7 +34,17 @@ func resourceTFEPolicy() *schema.Resource {
 			StateContext: resourceTFEPolicyImporter,
 		},
 
-		CustomizeDiff: customizeDiffIfProviderDefaultOrganizationChanged,
+		CustomizeDiff: func(c context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := validateEnforceModeForKind(c, d); err != nil {
+				return err
+			}
+
+			if err := customizeDiffPolicyContentHash(c, d); err != nil {
+				return err
+			}
+
+			return customizeDiffIfProviderDefaultOrganizationChanged(c, d, meta)
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -75,9 +88,24 @@ func resourceTFEPolicy() *schema.Resource {
 			},
 
 			"policy": {
-				Description: "Text of a valid Sentinel or OPA policy",
+				Description:  "Text of a valid Sentinel or OPA policy",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"policy", "policy_file"},
+			},
+
+			"policy_file": {
+				Description:  "Path to a local file containing a valid Sentinel or OPA policy",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"content_hash": {
+				Description: "SHA-256 hash of the policy content, used to detect changes to the policy",
 				Type:        schema.TypeString,
-				Required:    true,
+				Computed:    true,
 			},
 
 			"enforce_mode": {
@@ -172,7 +200,11 @@ func resourceTFEPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(policy.ID)
 
 	log.Printf("[DEBUG] Upload %s policy %s for organization: %s", kind, name, organization)
-	err = config.Client.Policies.Upload(ctx, policy.ID, []byte(d.Get("policy").(string)))
+	content, err := policyContent(d)
+	if err != nil {
+		return err
+	}
+	err = config.Client.Policies.Upload(ctx, policy.ID, content)
 	if err != nil {
 		return fmt.Errorf(
 			"Error uploading %s policy %s for organization %s: %w", kind, name, organization, err)
@@ -222,6 +254,109 @@ func createSentinelPolicyOptions(options *tfe.PolicyCreateOptions, d *schema.Res
 	return options
 }
 
+// validateEnforceModeForKind makes sure the configured enforce_mode is one of
+// the enforcement levels supported by the policy kind. Sentinel and OPA share
+// the `advisory` level, but the mandatory levels differ between the two.
+func validateEnforceModeForKind(_ context.Context, d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("enforce_mode") || !d.NewValueKnown("kind") {
+		return nil
+	}
+
+	mode, ok := d.GetOk("enforce_mode")
+	if !ok {
+		return nil
+	}
+
+	kind := d.Get("kind").(string)
+	var levels []string
+	switch tfe.PolicyKind(kind) {
+	case tfe.Sentinel:
+		levels = sentinelPolicyEnforcementLevels()
+	case tfe.OPA:
+		levels = opaPolicyEnforcementLevels()
+	default:
+		return nil
+	}
+
+	for _, level := range levels {
+		if mode.(string) == level {
+			return nil
+		}
+	}
+
+	return fmt.Errorf(
+		"invalid enforce_mode %q for %s policy: must be one of %s",
+		mode, kind, sentenceList(levels, "", "", "or"))
+}
+
+// policyContent returns the policy code to upload, read from policy_file when
+// it is set.
+func policyContent(d *schema.ResourceData) ([]byte, error) {
+	if file, ok := d.GetOk("policy_file"); ok {
+		return readPolicyFile(file.(string))
+	}
+
+	return []byte(d.Get("policy").(string)), nil
+}
+
+// readPolicyFile reads the policy code from the given policy_file path.
+func readPolicyFile(path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading policy file %s: %w", path, err)
+	}
+	return content, nil
+}
+
+func policyContentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// customizeDiffPolicyContentHash hashes the configured policy during plan, so
+// changes to the contents of policy_file show up as a diff of content_hash
+// even though the path stays the same.
+func customizeDiffPolicyContentHash(_ context.Context, d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("policy_file") {
+		if err := d.SetNewComputed("content_hash"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("policy")
+	}
+
+	file, usesFile := d.GetOk("policy_file")
+	if !usesFile && !d.NewValueKnown("policy") {
+		return d.SetNewComputed("content_hash")
+	}
+
+	var content []byte
+	if usesFile {
+		var err error
+		content, err = readPolicyFile(file.(string))
+		if err != nil {
+			return err
+		}
+	} else {
+		content = []byte(d.Get("policy").(string))
+	}
+
+	hash := policyContentHash(content)
+	if d.Get("content_hash").(string) == hash {
+		return nil
+	}
+
+	if err := d.SetNew("content_hash", hash); err != nil {
+		return err
+	}
+
+	// The policy attribute mirrors the uploaded file, so it changes as well.
+	if usesFile {
+		return d.SetNewComputed("policy")
+	}
+
+	return nil
+}
+
 func getDefaultEnforcementMode(kind tfe.PolicyKind) tfe.EnforcementLevel {
 	switch kind {
 	case tfe.Sentinel:
@@ -263,6 +398,7 @@ func resourceTFEPolicyRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error downloading policy %s: %w", d.Id(), err)
 	}
 	d.Set("policy", string(content))
+	d.Set("content_hash", policyContentHash(content))
 
 	return nil
 }
@@ -307,10 +443,15 @@ func resourceTFEPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("policy") {
+	if d.HasChange("policy") || d.HasChange("content_hash") {
 		vKind := d.Get("kind").(string)
+		content, err := policyContent(d)
+		if err != nil {
+			return err
+		}
+
 		log.Printf("[DEBUG] Update %s policy: %s", vKind, d.Id())
-		err := config.Client.Policies.Upload(ctx, d.Id(), []byte(d.Get("policy").(string)))
+		err = config.Client.Policies.Upload(ctx, d.Id(), content)
 		if err != nil {
 			return fmt.Errorf("Error updating %s policy %s: %w", vKind, d.Id(), err)
 		}
@@ -143,6 +143,14 @@ func resourceTFEPolicySet() *schema.Resource {
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				ConflictsWith: []string{"global"},
 			},
+
+			"project_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"global"},
+			},
 		},
 	}
 }
@@ -204,6 +212,10 @@ func resourceTFEPolicySetCreate(d *schema.ResourceData, meta interface{}) error
 		options.Workspaces = append(options.Workspaces, &tfe.Workspace{ID: workspaceID.(string)})
 	}
 
+	for _, projectID := range d.Get("project_ids").(*schema.Set).List() {
+		options.Projects = append(options.Projects, &tfe.Project{ID: projectID.(string)})
+	}
+
 	log.Printf("[DEBUG] Create policy set %s for organization: %s", name, organization)
 	policySet, err := config.Client.PolicySets.Create(ctx, organization, options)
 	if err != nil {
@@ -298,6 +310,15 @@ func resourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("workspace_ids", workspaceIDs)
 
+	// Update the projects.
+	var projectIDs []interface{}
+	if !policySet.Global {
+		for _, project := range policySet.Projects {
+			projectIDs = append(projectIDs, project.ID)
+		}
+	}
+	d.Set("project_ids", projectIDs)
+
 	return nil
 }
 
@@ -327,6 +348,23 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 				return fmt.Errorf("Error detaching policy set %s from workspaces: %w", d.Id(), err)
 			}
 		}
+
+		// Same for the projects.
+		oldProjectIDs, _ := d.GetChange("project_ids")
+
+		if oldProjectIDs.(*schema.Set).Len() > 0 {
+			options := tfe.PolicySetRemoveProjectsOptions{}
+
+			for _, projectID := range oldProjectIDs.(*schema.Set).List() {
+				options.Projects = append(options.Projects, &tfe.Project{ID: projectID.(string)})
+			}
+
+			log.Printf("[DEBUG] Removing previous projects from now-global policy set: %s", d.Id())
+			err := config.Client.PolicySets.RemoveProjects(ctx, d.Id(), options)
+			if err != nil {
+				return fmt.Errorf("Error detaching policy set %s from projects: %w", d.Id(), err)
+			}
+		}
 	}
 
 	// Don't bother updating the policy set's attributes if they haven't changed
@@ -449,6 +487,45 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if !global && d.HasChange("project_ids") {
+		oldProjectIDValues, newProjectIDValues := d.GetChange("project_ids")
+		newProjectIDsSet := newProjectIDValues.(*schema.Set)
+		oldProjectIDsSet := oldProjectIDValues.(*schema.Set)
+
+		newProjectIDs := newProjectIDsSet.Difference(oldProjectIDsSet)
+		oldProjectIDs := oldProjectIDsSet.Difference(newProjectIDsSet)
+
+		// First add the new projects.
+		if newProjectIDs.Len() > 0 {
+			options := tfe.PolicySetAddProjectsOptions{}
+
+			for _, projectID := range newProjectIDs.List() {
+				options.Projects = append(options.Projects, &tfe.Project{ID: projectID.(string)})
+			}
+
+			log.Printf("[DEBUG] Attach policy set to projects: %s", d.Id())
+			err := config.Client.PolicySets.AddProjects(ctx, d.Id(), options)
+			if err != nil {
+				return fmt.Errorf("Error attaching policy set %s to projects: %w", d.Id(), err)
+			}
+		}
+
+		// Then remove all the old projects.
+		if oldProjectIDs.Len() > 0 {
+			options := tfe.PolicySetRemoveProjectsOptions{}
+
+			for _, projectID := range oldProjectIDs.List() {
+				options.Projects = append(options.Projects, &tfe.Project{ID: projectID.(string)})
+			}
+
+			log.Printf("[DEBUG] Detach policy set from projects: %s", d.Id())
+			err := config.Client.PolicySets.RemoveProjects(ctx, d.Id(), options)
+			if err != nil {
+				return fmt.Errorf("Error detaching policy set %s from projects: %w", d.Id(), err)
+			}
+		}
+	}
+
 	return resourceTFEPolicySetRead(d, meta)
 }
 
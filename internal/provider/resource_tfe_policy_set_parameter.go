@@ -52,6 +52,11 @@ func resourceTFEPolicySetParameter() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			"category": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -110,6 +115,7 @@ func resourceTFEPolicySetParameterRead(d *schema.ResourceData, meta interface{})
 	// Update config.
 	d.Set("key", parameter.Key)
 	d.Set("sensitive", parameter.Sensitive)
+	d.Set("category", string(parameter.Category))
 
 	// Only set the value if its not sensitive, as otherwise it will be empty.
 	if !parameter.Sensitive {
@@ -40,6 +40,8 @@ func TestAccTFEPolicySetParameter_basic(t *testing.T) {
 						"tfe_policy_set_parameter.foobar", "value", "value_test"),
 					resource.TestCheckResourceAttr(
 						"tfe_policy_set_parameter.foobar", "sensitive", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set_parameter.foobar", "category", "policy-set"),
 				),
 			},
 		},
@@ -195,6 +195,44 @@ func TestAccTFEPolicySet_update(t *testing.T) {
 	})
 }
 
+func TestAccTFEPolicySet_projects(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	policySet := &tfe.PolicySet{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEPolicySetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEPolicySet_projects(org.Name, "[tfe_project.foo.id, tfe_project.bar.id]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEPolicySetExists("tfe_policy_set.foobar", policySet),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "project_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccTFEPolicySet_projects(org.Name, "[tfe_project.bar.id]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEPolicySetExists("tfe_policy_set.foobar", policySet),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "project_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"tfe_policy_set.foobar", "project_ids.*", "tfe_project.bar", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFEPolicySet_updateEmpty(t *testing.T) {
 	tfeClient, err := getClientUsingEnv()
 	if err != nil {
@@ -1024,6 +1062,36 @@ resource "tfe_policy_set" "foobar" {
 }`, organization)
 }
 
+func testAccTFEPolicySet_projects(organization, projectIDs string) string {
+	return fmt.Sprintf(`
+locals {
+  organization_name = "%s"
+}
+
+resource "tfe_sentinel_policy" "foo" {
+  name         = "policy-foo"
+  policy       = "main = rule { true }"
+  organization = local.organization_name
+}
+
+resource "tfe_project" "foo" {
+  name         = "project-foo"
+  organization = local.organization_name
+}
+
+resource "tfe_project" "bar" {
+  name         = "project-bar"
+  organization = local.organization_name
+}
+
+resource "tfe_policy_set" "foobar" {
+  name         = "terraform-projects"
+  organization = local.organization_name
+  policy_ids   = [tfe_sentinel_policy.foo.id]
+  project_ids  = %s
+}`, organization, projectIDs)
+}
+
 func testAccTFEPolicySetOPA_overridable(organization string) string {
 	return fmt.Sprintf(`
 locals {
@@ -5,6 +5,9 @@ package provider
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
 
 	tfe "github.com/hashicorp/go-tfe"
@@ -185,6 +188,105 @@ func TestAccTFEPolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccTFEPolicy_policyFile(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	policy := &tfe.Policy{}
+	policyFile := filepath.Join(t.TempDir(), "policy.sentinel")
+	writePolicyFile := func(content string) {
+		if err := os.WriteFile(policyFile, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { writePolicyFile("main = rule { true }") },
+				Config:    testAccTFEPolicy_policyFile(org.Name, policyFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEPolicyExists(
+						"tfe_policy.foobar", policy),
+					testAccCheckTFEPolicyContent(policy, "main = rule { true }"),
+					resource.TestCheckResourceAttr(
+						"tfe_policy.foobar", "policy", "main = rule { true }"),
+					resource.TestCheckResourceAttr(
+						"tfe_policy.foobar", "content_hash", policyContentHash([]byte("main = rule { true }"))),
+				),
+			},
+			{
+				PreConfig: func() { writePolicyFile("main = rule { false }") },
+				Config:    testAccTFEPolicy_policyFile(org.Name, policyFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEPolicyExists(
+						"tfe_policy.foobar", policy),
+					testAccCheckTFEPolicyContent(policy, "main = rule { false }"),
+					resource.TestCheckResourceAttr(
+						"tfe_policy.foobar", "policy", "main = rule { false }"),
+					resource.TestCheckResourceAttr(
+						"tfe_policy.foobar", "content_hash", policyContentHash([]byte("main = rule { false }"))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEPolicy_enforceModes(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	policy := &tfe.Policy{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEPolicy_enforceMode(org.Name, "advisory"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEPolicyExists(
+						"tfe_policy.foobar", policy),
+					resource.TestCheckResourceAttr(
+						"tfe_policy.foobar", "enforce_mode", "advisory"),
+				),
+			},
+			{
+				Config: testAccTFEPolicy_enforceMode(org.Name, "soft-mandatory"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_policy.foobar", "enforce_mode", "soft-mandatory"),
+				),
+			},
+			{
+				Config: testAccTFEPolicy_enforceMode(org.Name, "hard-mandatory"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_policy.foobar", "enforce_mode", "hard-mandatory"),
+				),
+			},
+			{
+				Config:      testAccTFEPolicy_enforceMode(org.Name, "mandatory"),
+				ExpectError: regexp.MustCompile(`invalid enforce_mode "mandatory" for sentinel policy`),
+			},
+		},
+	})
+}
+
 func TestAccTFEPolicy_unsetEnforce(t *testing.T) {
 	skipUnlessBeta(t)
 	tfeClient, err := getClientUsingEnv()
@@ -536,6 +638,17 @@ resource "tfe_policy" "foobar" {
 }`, organization)
 }
 
+func testAccTFEPolicy_policyFile(organization, policyFile string) string {
+	return fmt.Sprintf(`
+resource "tfe_policy" "foobar" {
+  name         = "policy-test"
+  description  = "A test policy"
+  organization = "%s"
+  policy_file  = "%s"
+  enforce_mode = "hard-mandatory"
+}`, organization, policyFile)
+}
+
 func testAccTFEPolicy_basicWithDefaults(organization string) string {
 	return fmt.Sprintf(`
 resource "tfe_policy" "foobar" {
@@ -570,6 +683,17 @@ resource "tfe_policy" "foobar" {
 }`, organization)
 }
 
+func testAccTFEPolicy_enforceMode(organization, enforceMode string) string {
+	return fmt.Sprintf(`
+resource "tfe_policy" "foobar" {
+  name         = "policy-test"
+  description  = "A test policy"
+  organization = "%s"
+  policy       = "main = rule { true }"
+  enforce_mode = "%s"
+}`, organization, enforceMode)
+}
+
 func testAccTFEPolicy_emptyEnforce(organization string) string {
 	return fmt.Sprintf(`
   resource "tfe_policy" "foobar" {
@@ -419,7 +419,20 @@ func resourceTFERegistryModuleDelete(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[DEBUG] Delete registry module: %s", d.Id())
 	organization := d.Get("organization").(string)
 	name := d.Get("name").(string)
-	err := config.Client.RegistryModules.Delete(ctx, organization, name)
+	moduleProvider := d.Get("module_provider").(string)
+
+	var err error
+	if d.Get("registry_name").(string) == string(tfe.PublicRegistry) || moduleProvider == "" {
+		err = config.Client.RegistryModules.Delete(ctx, organization, name)
+	} else {
+		// Only delete the provider managed by this resource, so other providers
+		// of a module with the same name are left untouched.
+		err = config.Client.RegistryModules.DeleteProvider(ctx, tfe.RegistryModuleID{
+			Organization: organization,
+			Name:         name,
+			Provider:     moduleProvider,
+		})
+	}
 	if err != nil {
 		if err == tfe.ErrResourceNotFound {
 			return nil
@@ -202,6 +202,44 @@ func TestAccTFERegistryModule_nonVCSPrivateRegistryModuleWithoutRegistryName(t *
 	})
 }
 
+func TestAccTFERegistryModule_deleteOnlyManagedProvider(t *testing.T) {
+	registryModule := &tfe.RegistryModule{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	orgName := fmt.Sprintf("tst-terraform-%d", rInt)
+
+	barID := tfe.RegistryModuleID{
+		Organization: orgName,
+		Name:         "test_module",
+		Provider:     "bar_provider",
+		RegistryName: tfe.PrivateRegistry,
+		Namespace:    orgName,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFERegistryModuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERegistryModule_privateRMTwoProviders(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFERegistryModuleExists("tfe_registry_module.bar", barID, registryModule),
+				),
+			},
+			{
+				// Removing the foo provider must not delete the bar provider of
+				// the module with the same name.
+				Config: testAccTFERegistryModule_privateRMTwoProviders(rInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFERegistryModuleExists("tfe_registry_module.bar", barID, registryModule),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFERegistryModule_nonVCSPrivateRegistryModuleWithRegistryName(t *testing.T) {
 	registryModule := &tfe.RegistryModule{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -1257,6 +1295,31 @@ resource "tfe_registry_module" "foobar" {
 		rInt)
 }
 
+func testAccTFERegistryModule_privateRMTwoProviders(rInt int, withFoo bool) string {
+	foo := ""
+	if withFoo {
+		foo = `
+resource "tfe_registry_module" "foo" {
+  organization    = tfe_organization.foobar.id
+  module_provider = "foo_provider"
+  name            = "test_module"
+}`
+	}
+
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_registry_module" "bar" {
+  organization    = tfe_organization.foobar.id
+  module_provider = "bar_provider"
+  name            = "test_module"
+}
+%s`, rInt, foo)
+}
+
 func testAccTFERegistryModule_privateRMWithRegistryName(rInt int) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
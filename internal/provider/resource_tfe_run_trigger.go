@@ -9,6 +9,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -28,6 +29,8 @@ func resourceTFERunTrigger() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: validateRunTriggerSourceable,
+
 		Schema: map[string]*schema.Schema{
 			"workspace_id": {
 				Type:     schema.TypeString,
@@ -43,6 +46,21 @@ func resourceTFERunTrigger() *schema.Resource {
 	}
 }
 
+// validateRunTriggerSourceable rejects run triggers whose source is the
+// workspace they trigger runs in.
+func validateRunTriggerSourceable(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("workspace_id") || !d.NewValueKnown("sourceable_id") {
+		return nil
+	}
+
+	workspaceID := d.Get("workspace_id").(string)
+	if workspaceID != "" && workspaceID == d.Get("sourceable_id").(string) {
+		return fmt.Errorf("sourceable_id must not be the same as workspace_id %s: a workspace can't trigger runs in itself", workspaceID)
+	}
+
+	return nil
+}
+
 func resourceTFERunTriggerCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(ConfiguredClient)
 
@@ -94,6 +112,14 @@ func resourceTFERunTriggerRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error reading run trigger %s: %w", d.Id(), err)
 	}
 
+	// A run trigger without a source workspace can't trigger any runs, so
+	// treat it as gone and let Terraform recreate it.
+	if runTrigger.Sourceable == nil {
+		log.Printf("[DEBUG] Source workspace of run trigger %s no longer exists", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	d.Set("workspace_id", runTrigger.Workspace.ID)
 	d.Set("sourceable_id", runTrigger.Sourceable.ID)
 
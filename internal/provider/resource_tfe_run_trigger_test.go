@@ -6,6 +6,7 @@ package provider
 import (
 	"fmt"
 	"math/rand"
+	"regexp"
 	"testing"
 	"time"
 
@@ -76,6 +77,22 @@ func TestAccTFERunTriggerImport(t *testing.T) {
 	})
 }
 
+func TestAccTFERunTrigger_sameWorkspace(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFERunTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFERunTrigger_sameWorkspace(rInt),
+				ExpectError: regexp.MustCompile(`a workspace can't trigger runs in itself`),
+			},
+		},
+	})
+}
+
 func testAccCheckTFERunTriggerExists(n string, runTrigger *tfe.RunTrigger) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(ConfiguredClient)
@@ -164,6 +181,24 @@ resource "tfe_run_trigger" "foobar" {
 }`, rInt)
 }
 
+func testAccTFERunTrigger_sameWorkspace(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "workspace" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_run_trigger" "foobar" {
+  workspace_id  = tfe_workspace.workspace.id
+  sourceable_id = tfe_workspace.workspace.id
+}`, rInt)
+}
+
 func testAccTFERunTrigger_many(rInt int) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
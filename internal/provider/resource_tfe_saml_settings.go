@@ -231,6 +231,15 @@ func (r *resourceTFESAMLSettings) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	// This resource always enables SAML, so settings that were disabled
+	// outside of Terraform are treated as deleted and will be created, and
+	// thereby enabled, again.
+	if !samlSettings.Enabled {
+		tflog.Debug(ctx, "SAML Settings were disabled outside of Terraform")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	result := modelFromTFEAdminSAMLSettings(*samlSettings, m.PrivateKey)
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
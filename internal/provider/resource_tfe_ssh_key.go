@@ -9,11 +9,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/ssh"
 )
 
 func resourceTFESSHKey() *schema.Resource {
@@ -22,8 +25,17 @@ func resourceTFESSHKey() *schema.Resource {
 		Read:   resourceTFESSHKeyRead,
 		Update: resourceTFESSHKeyUpdate,
 		Delete: resourceTFESSHKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTFESSHKeyImporter,
+		},
+
+		CustomizeDiff: func(c context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := forceNewSSHKeyOnKeyChange(c, d); err != nil {
+				return err
+			}
 
-		CustomizeDiff: customizeDiffIfProviderDefaultOrganizationChanged,
+			return customizeDiffIfProviderDefaultOrganizationChanged(c, d, meta)
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -43,6 +55,11 @@ func resourceTFESSHKey() *schema.Resource {
 				Required:  true,
 				Sensitive: true,
 			},
+
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -72,9 +89,44 @@ func resourceTFESSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(sshKey.ID)
 
+	// The API never returns the key, so the fingerprint is taken from the key
+	// that was uploaded. It is left null if the key can't be parsed.
+	if fingerprint := sshKeyFingerprint(d.Get("key").(string)); fingerprint != "" {
+		d.Set("fingerprint", fingerprint)
+	} else {
+		d.Set("fingerprint", nil)
+	}
+
 	return resourceTFESSHKeyUpdate(d, meta)
 }
 
+// forceNewSSHKeyOnKeyChange replaces the SSH key when its key changes, since
+// the API can only update the name. Imported SSH keys have no key in state,
+// so setting it for the first time after an import is not a change.
+func forceNewSSHKeyOnKeyChange(_ context.Context, d *schema.ResourceDiff) error {
+	if d.Id() == "" || !d.HasChange("key") {
+		return nil
+	}
+
+	if oldKey, _ := d.GetChange("key"); oldKey.(string) == "" {
+		return nil
+	}
+
+	return d.ForceNew("key")
+}
+
+// sshKeyFingerprint returns the SHA256 fingerprint of the public half of a
+// private SSH key, in the same format as ssh-keygen -l. It returns an empty
+// string if the key can't be parsed, for example when it is encrypted.
+func sshKeyFingerprint(key string) string {
+	signer, err := ssh.ParsePrivateKey([]byte(key))
+	if err != nil {
+		log.Printf("[DEBUG] Unable to compute fingerprint of SSH key: %v", err)
+		return ""
+	}
+	return ssh.FingerprintSHA256(signer.PublicKey())
+}
+
 func resourceTFESSHKeyRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(ConfiguredClient)
 
@@ -126,3 +178,32 @@ func resourceTFESSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func resourceTFESSHKeyImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(ConfiguredClient)
+
+	s := strings.Split(d.Id(), "/")
+	if len(s) >= 3 {
+		return nil, fmt.Errorf(
+			"invalid SSH key input format: %s (expected <ORGANIZATION>/<SSH KEY NAME> or <SSH KEY ID>)",
+			d.Id(),
+		)
+	} else if len(s) == 2 {
+		org := s[0]
+		name := s[1]
+		sshKey, err := fetchSSHKey(org, name, config.Client)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error retrieving SSH key with name %s from organization %s: %w", name, org, err)
+		}
+
+		d.Set("organization", org)
+		d.SetId(sshKey.ID)
+	} else {
+		// The API doesn't return the organization of an SSH key, so assume
+		// it belongs to the default organization.
+		d.Set("organization", config.Organization)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -4,6 +4,10 @@
 package provider
 
 import (
+	cryptorand "crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"math/rand"
 	"testing"
@@ -12,6 +16,7 @@ import (
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"golang.org/x/crypto/ssh"
 )
 
 func TestAccTFESSHKey_basic(t *testing.T) {
@@ -33,12 +38,74 @@ func TestAccTFESSHKey_basic(t *testing.T) {
 						"tfe_ssh_key.foobar", "name", "ssh-key-test"),
 					resource.TestCheckResourceAttr(
 						"tfe_ssh_key.foobar", "key", "SSH-KEY-CONTENT"),
+					// The test key isn't a real private key.
+					resource.TestCheckNoResourceAttr(
+						"tfe_ssh_key.foobar", "fingerprint"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccTFESSHKey_import(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFESSHKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFESSHKey_basic(rInt),
+			},
+			{
+				ResourceName:      "tfe_ssh_key.foobar",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("tst-terraform-%d/ssh-key-test", rInt),
+				ImportStateVerify: true,
+				// The API returns neither the key nor anything to derive the
+				// fingerprint from.
+				ImportStateVerifyIgnore: []string{"key", "fingerprint"},
+			},
+		},
+	})
+}
+
+func TestSSHKeyFingerprint(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(cryptorand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := ssh.NewPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	})
+
+	cases := map[string]struct {
+		key      string
+		expected string
+	}{
+		"valid key": {
+			key:      string(key),
+			expected: ssh.FingerprintSHA256(publicKey),
+		},
+		"invalid key": {
+			key:      "SSH-KEY-CONTENT",
+			expected: "",
+		},
+	}
+
+	for name, c := range cases {
+		if got := sshKeyFingerprint(c.key); got != c.expected {
+			t.Errorf("%s: expected %q, got %q", name, c.expected, got)
+		}
+	}
+}
+
 func TestAccTFESSHKey_update(t *testing.T) {
 	sshKey := &tfe.SSHKey{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
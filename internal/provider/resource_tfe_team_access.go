@@ -348,10 +348,26 @@ func resourceTFETeamAccessDelete(d *schema.ResourceData, meta interface{}) error
 func resourceTFETeamAccessImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	config := meta.(ConfiguredClient)
 
+	// Import formats:
+	//  - <ORGANIZATION>/<WORKSPACE>/<TEAM ACCESS ID>
+	//  - <WORKSPACE ID>/<TEAM ID>
 	s := strings.SplitN(d.Id(), "/", 3)
+	if len(s) == 2 && isResourceIDFormat("ws", s[0]) && isResourceIDFormat("team", s[1]) {
+		tmAccess, err := fetchTeamAccessByTeamID(ctx, config.Client, s[0], s[1])
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error retrieving team access for team %s on workspace %s: %w", s[1], s[0], err)
+		}
+
+		d.Set("workspace_id", s[0])
+		d.SetId(tmAccess.ID)
+
+		return []*schema.ResourceData{d}, nil
+	}
+
 	if len(s) != 3 {
 		return nil, fmt.Errorf(
-			"invalid team access import format: %s (expected <ORGANIZATION>/<WORKSPACE>/<TEAM ACCESS ID>)",
+			"invalid team access import format: %s (expected <ORGANIZATION>/<WORKSPACE>/<TEAM ACCESS ID> or <WORKSPACE ID>/<TEAM ID>)",
 			d.Id(),
 		)
 	}
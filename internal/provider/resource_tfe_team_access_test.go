@@ -281,6 +281,39 @@ func TestAccTFETeamAccess_import(t *testing.T) {
 	})
 }
 
+func TestAccTFETeamAccess_importByWorkspaceAndTeamID(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETeamAccess_write(rInt),
+			},
+
+			{
+				ResourceName:      "tfe_team_access.foobar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccTFETeamAccessImportStateIDByWorkspaceAndTeamID("tfe_team_access.foobar"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTFETeamAccessImportStateIDByWorkspaceAndTeamID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["workspace_id"], rs.Primary.Attributes["team_id"]), nil
+	}
+}
+
 func testAccCheckTFETeamAccessExists(
 	n string, tmAccess *tfe.TeamAccess) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -9,6 +9,7 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -57,7 +58,7 @@ func resourceTFETeamMemberCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Add user %q to team: %s", username, teamID)
 	err := config.Client.TeamMembers.Add(ctx, teamID, options)
 	if err != nil {
-		return fmt.Errorf("Error adding user %q to team %s: %w", username, teamID, err)
+		return teamMemberAddError(teamID, []string{username}, err)
 	}
 
 	d.SetId(packTeamMemberID(teamID, username))
@@ -130,6 +131,26 @@ func resourceTFETeamMemberDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// teamMemberAddError wraps an error from adding users to a team. Users that
+// were invited to the organization but haven't accepted the invitation yet
+// can't be added by username, and the API only reports them as not found.
+func teamMemberAddError(teamID string, usernames []string, err error) error {
+	users := fmt.Sprintf("user %q", usernames[0])
+	if len(usernames) > 1 {
+		users = fmt.Sprintf("users %q", usernames)
+	}
+
+	if errors.Is(err, tfe.ErrResourceNotFound) || strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return fmt.Errorf(
+			"Error adding %s to team %s: %w. Users must be members of the organization "+
+				"before they can be added to a team, so make sure any pending invitation "+
+				"was accepted, or use tfe_team_organization_member, which also works for "+
+				"invited users", users, teamID, err)
+	}
+
+	return fmt.Errorf("Error adding %s to team %s: %w", users, teamID, err)
+}
+
 func packTeamMemberID(teamID, username string) string {
 	return teamID + "/" + username
 }
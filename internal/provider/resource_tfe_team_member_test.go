@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
@@ -80,6 +81,49 @@ func TestUnpackTeamMemberID(t *testing.T) {
 	}
 }
 
+func TestTeamMemberAddError(t *testing.T) {
+	cases := []struct {
+		usernames []string
+		err       error
+		message   string
+		hint      bool
+	}{
+		{
+			usernames: []string{"sander"},
+			err:       tfe.ErrResourceNotFound,
+			message:   `Error adding user "sander" to team team-47qC3LmA47piVan7: resource not found.`,
+			hint:      true,
+		},
+		{
+			usernames: []string{"sander", "admin"},
+			err:       errors.New("user not found in organization"),
+			message:   `Error adding users ["sander" "admin"] to team team-47qC3LmA47piVan7: user not found in organization.`,
+			hint:      true,
+		},
+		{
+			usernames: []string{"sander"},
+			err:       tfe.ErrUnauthorized,
+			message:   `Error adding user "sander" to team team-47qC3LmA47piVan7: unauthorized`,
+			hint:      false,
+		},
+	}
+
+	for _, tc := range cases {
+		err := teamMemberAddError("team-47qC3LmA47piVan7", tc.usernames, tc.err)
+		if !errors.Is(err, tc.err) {
+			t.Fatalf("expected error to wrap %v, got %v", tc.err, err)
+		}
+
+		if !strings.HasPrefix(err.Error(), tc.message) {
+			t.Fatalf("expected error to start with %q, got %q", tc.message, err.Error())
+		}
+
+		if hint := strings.Contains(err.Error(), "pending invitation"); hint != tc.hint {
+			t.Fatalf("expected hint is %t, got %q", tc.hint, err.Error())
+		}
+	}
+}
+
 // Thanks to a quirk of our CI environment, this test assumes that
 // the token used to run the tests (aka the TFE_TOKEN environment variable)
 // belongs to a user with the username "admin" and will fail otherwise.
@@ -14,15 +14,16 @@ import (
 	"log"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFETeamMembers() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFETeamMembersCreate,
-		Read:   resourceTFETeamMembersRead,
-		Update: resourceTFETeamMembersUpdate,
-		Delete: resourceTFETeamMembersDelete,
+		CreateContext: resourceTFETeamMembersCreate,
+		Read:          resourceTFETeamMembersRead,
+		UpdateContext: resourceTFETeamMembersUpdate,
+		Delete:        resourceTFETeamMembersDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFETeamMembersImporter,
 		},
@@ -43,29 +44,29 @@ func resourceTFETeamMembers() *schema.Resource {
 	}
 }
 
-func resourceTFETeamMembersCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamMembersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID.
 	teamID := d.Get("team_id").(string)
 
-	// Create a new options struct.
-	options := tfe.TeamMemberAddOptions{}
-
 	// Add all the users that need to be added.
+	var usernames []string
 	for _, username := range d.Get("usernames").(*schema.Set).List() {
-		options.Usernames = append(options.Usernames, username.(string))
+		usernames = append(usernames, username.(string))
 	}
 
-	log.Printf("[DEBUG] Add users to team: %s", teamID)
-	err := config.Client.TeamMembers.Add(ctx, teamID, options)
-	if err != nil {
-		return fmt.Errorf("Error adding users to team %s: %w", teamID, err)
+	added, diags := addTeamMembers(ctx, config.Client.TeamMembers, teamID, usernames)
+	if diags.HasError() {
+		return diags
 	}
 
 	d.SetId(teamID)
 
-	return nil
+	// Only keep the users that were added, so the others show up in the next plan.
+	d.Set("usernames", added)
+
+	return diags
 }
 
 func resourceTFETeamMembersRead(d *schema.ResourceData, meta interface{}) error {
@@ -97,9 +98,10 @@ func resourceTFETeamMembersRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceTFETeamMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamMembersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
+	var diags diag.Diagnostics
 	if d.HasChange("usernames") {
 		oldUsernames, newUsernames := d.GetChange("usernames")
 		oldUsers := oldUsernames.(*schema.Set).Difference(newUsernames.(*schema.Set))
@@ -107,18 +109,25 @@ func resourceTFETeamMembersUpdate(d *schema.ResourceData, meta interface{}) erro
 
 		// First add the new users.
 		if newUsers.Len() > 0 {
-			// Create a new options struct.
-			options := tfe.TeamMemberAddOptions{}
-
 			// Add all the users that need to be added.
+			var usernames []string
 			for _, username := range newUsers.List() {
-				options.Usernames = append(options.Usernames, username.(string))
+				usernames = append(usernames, username.(string))
 			}
 
-			log.Printf("[DEBUG] Add users to team: %s", d.Id())
-			err := config.Client.TeamMembers.Add(ctx, d.Id(), options)
-			if err != nil {
-				return fmt.Errorf("Error adding users to team %s: %w", d.Id(), err)
+			var added []string
+			added, diags = addTeamMembers(ctx, config.Client.TeamMembers, d.Id(), usernames)
+			if diags.HasError() {
+				return diags
+			}
+
+			// Drop the users that couldn't be added, so they show up in the next plan.
+			if len(added) < len(usernames) {
+				current := newUsernames.(*schema.Set).Difference(newUsers)
+				for _, username := range added {
+					current.Add(username)
+				}
+				d.Set("usernames", current)
 			}
 		}
 
@@ -135,12 +144,53 @@ func resourceTFETeamMembersUpdate(d *schema.ResourceData, meta interface{}) erro
 			log.Printf("[DEBUG] Remove users from team: %s", d.Id())
 			err := config.Client.TeamMembers.Remove(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error removing users to team %s: %w", d.Id(), err)
+				return append(diags, diag.Errorf("Error removing users to team %s: %v", d.Id(), err)...)
 			}
 		}
 	}
 
-	return nil
+	return diags
+}
+
+type teamMemberAdder interface {
+	Add(ctx context.Context, teamID string, options tfe.TeamMemberAddOptions) error
+}
+
+// addTeamMembers adds users to a team and returns the ones that were added.
+// When adding them all at once fails, each user is added on its own and the
+// users that still fail are reported as warnings, so a single unknown user
+// doesn't block the rest of the team. It only errors if no user was added.
+func addTeamMembers(ctx context.Context, a teamMemberAdder, teamID string, usernames []string) ([]string, diag.Diagnostics) {
+	log.Printf("[DEBUG] Add users to team: %s", teamID)
+	err := a.Add(ctx, teamID, tfe.TeamMemberAddOptions{Usernames: usernames})
+	if err == nil {
+		return usernames, nil
+	}
+	if len(usernames) == 1 {
+		return nil, diag.FromErr(teamMemberAddError(teamID, usernames, err))
+	}
+
+	log.Printf("[DEBUG] Adding users to team %s failed, adding them one by one: %v", teamID, err)
+	var added []string
+	var diags diag.Diagnostics
+	for _, username := range usernames {
+		err := a.Add(ctx, teamID, tfe.TeamMemberAddOptions{Usernames: []string{username}})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Unable to add user %q to team %s", username, teamID),
+				Detail:   teamMemberAddError(teamID, []string{username}, err).Error(),
+			})
+			continue
+		}
+		added = append(added, username)
+	}
+
+	if len(added) == 0 {
+		return nil, diag.FromErr(teamMemberAddError(teamID, usernames, err))
+	}
+
+	return added, diags
 }
 
 func resourceTFETeamMembersDelete(d *schema.ResourceData, meta interface{}) error {
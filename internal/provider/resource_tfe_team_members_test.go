@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -12,11 +13,90 @@ import (
 	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+type mockTeamMemberAdder struct {
+	unknown map[string]bool
+	calls   int
+}
+
+func (m *mockTeamMemberAdder) Add(ctx context.Context, teamID string, options tfe.TeamMemberAddOptions) error {
+	m.calls++
+	for _, username := range options.Usernames {
+		if m.unknown[username] {
+			return tfe.ErrResourceNotFound
+		}
+	}
+	return nil
+}
+
+func TestAddTeamMembers(t *testing.T) {
+	tests := map[string]struct {
+		usernames []string
+		unknown   map[string]bool
+		added     []string
+		warnings  int
+		err       bool
+		calls     int
+	}{
+		"all users added at once": {
+			usernames: []string{"admin", "sander"},
+			added:     []string{"admin", "sander"},
+			calls:     1,
+		},
+		"unknown user is a warning": {
+			usernames: []string{"admin", "nobody", "sander"},
+			unknown:   map[string]bool{"nobody": true},
+			added:     []string{"admin", "sander"},
+			warnings:  1,
+			calls:     4,
+		},
+		"single unknown user is an error": {
+			usernames: []string{"nobody"},
+			unknown:   map[string]bool{"nobody": true},
+			err:       true,
+			calls:     1,
+		},
+		"all unknown users is an error": {
+			usernames: []string{"nobody", "someone"},
+			unknown:   map[string]bool{"nobody": true, "someone": true},
+			err:       true,
+			calls:     3,
+		},
+	}
+
+	for name, test := range tests {
+		a := &mockTeamMemberAdder{unknown: test.unknown}
+		added, diags := addTeamMembers(context.Background(), a, "team-47qC3LmA47piVan7", test.usernames)
+
+		if diags.HasError() != test.err {
+			t.Fatalf("%s: expected error is %t, got %v", name, test.err, diags)
+		}
+
+		var warnings int
+		for _, d := range diags {
+			if d.Severity == diag.Warning {
+				warnings++
+			}
+		}
+		if warnings != test.warnings {
+			t.Fatalf("%s: expected %d warnings, got %d", name, test.warnings, warnings)
+		}
+
+		if !reflect.DeepEqual(added, test.added) {
+			t.Fatalf("%s: expected added users %v, got %v", name, test.added, added)
+		}
+
+		if a.calls != test.calls {
+			t.Fatalf("%s: expected %d calls, got %d", name, test.calls, a.calls)
+		}
+	}
+}
+
 func TestAccTFETeamMembers_basic(t *testing.T) {
 	t.Skip("Skipping, due to current testing limitations; namely, an organization membership must first be confirmed.")
 	users := []*tfe.User{}
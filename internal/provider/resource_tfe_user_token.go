@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &resourceTFEUserToken{}
+var _ resource.ResourceWithConfigure = &resourceTFEUserToken{}
+
+func NewUserTokenResource() resource.Resource {
+	return &resourceTFEUserToken{}
+}
+
+// resourceTFEUserToken implements the tfe_user_token resource type
+type resourceTFEUserToken struct {
+	config ConfiguredClient
+}
+
+// modelTFEUserToken maps the resource schema data.
+type modelTFEUserToken struct {
+	ID          types.String `tfsdk:"id"`
+	UserID      types.String `tfsdk:"user_id"`
+	Description types.String `tfsdk:"description"`
+	ExpiredAt   types.String `tfsdk:"expired_at"`
+	Token       types.String `tfsdk:"token"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+func (r *resourceTFEUserToken) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_token"
+}
+
+func (r *resourceTFEUserToken) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an API token of a user, such as a service account.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the token.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "ID of the user the token belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the token.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expired_at": schema.StringAttribute{
+				Description: "The time when the token expires, in RFC3339 format. If omitted, the token doesn't expire.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: "The token. It is only returned when the token is created.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The time when the token was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *resourceTFEUserToken) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected resource Configure type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+	}
+	r.config = client
+}
+
+func (r *resourceTFEUserToken) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan modelTFEUserToken
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := tfe.UserTokenCreateOptions{
+		Description: plan.Description.ValueString(),
+	}
+
+	if !plan.ExpiredAt.IsNull() {
+		expiredAt, err := time.Parse(time.RFC3339, plan.ExpiredAt.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expired_at"),
+				"Invalid expiry",
+				fmt.Sprintf("%s must be a valid date or time, provided in iso8601 format", plan.ExpiredAt.ValueString()),
+			)
+			return
+		}
+		options.ExpiredAt = &expiredAt
+	}
+
+	userID := plan.UserID.ValueString()
+
+	tflog.Debug(ctx, "Creating user token", map[string]interface{}{"user_id": userID})
+	token, err := r.config.Client.UserTokens.Create(ctx, userID, options)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to create token for user %s", userID), err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(token.ID)
+	plan.CreatedAt = types.StringValue(token.CreatedAt.Format(time.RFC3339))
+
+	// The token is only returned once, during the creation of the token.
+	plan.Token = types.StringValue(token.Token)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceTFEUserToken) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state modelTFEUserToken
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "Reading user token", map[string]interface{}{"id": tokenID})
+	token, err := r.config.Client.UserTokens.Read(ctx, tokenID)
+	if err != nil {
+		if errors.Is(err, tfe.ErrResourceNotFound) {
+			tflog.Debug(ctx, "User token no longer exists", map[string]interface{}{"id": tokenID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to read user token %s", tokenID), err.Error())
+		return
+	}
+
+	// The API doesn't return the token again, so the token, and the
+	// expiry as configured, are carried forward from the prior state.
+	if token.Description != "" || !state.Description.IsNull() {
+		state.Description = types.StringValue(token.Description)
+	}
+	state.CreatedAt = types.StringValue(token.CreatedAt.Format(time.RFC3339))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceTFEUserToken) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement, tokens can't be
+	// modified once created.
+	resp.Diagnostics.AddError("Update not supported", "The update operation is not supported on this resource. This is a bug in the provider.")
+}
+
+func (r *resourceTFEUserToken) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state modelTFEUserToken
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "Deleting user token", map[string]interface{}{"id": tokenID})
+	err := r.config.Client.UserTokens.Delete(ctx, tokenID)
+	if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to delete user token %s", tokenID), err.Error())
+		return
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEUserToken_basic(t *testing.T) {
+	userID := testAccTFEUserTokenCurrentUserID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		CheckDestroy:             testAccCheckTFEUserTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEUserToken_basic(userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEUserTokenExists("tfe_user_token.foobar"),
+					resource.TestCheckResourceAttr(
+						"tfe_user_token.foobar", "user_id", userID),
+					resource.TestCheckResourceAttr(
+						"tfe_user_token.foobar", "description", "tst-user-token"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_user_token.foobar", "token"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_user_token.foobar", "created_at"),
+					resource.TestCheckNoResourceAttr(
+						"tfe_user_token.foobar", "expired_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEUserToken_withValidExpiry(t *testing.T) {
+	userID := testAccTFEUserTokenCurrentUserID(t)
+	expiredAt := "2051-04-11T23:15:59Z"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		CheckDestroy:             testAccCheckTFEUserTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEUserToken_withExpiry(userID, expiredAt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEUserTokenExists("tfe_user_token.expiry"),
+					resource.TestCheckResourceAttr(
+						"tfe_user_token.expiry", "expired_at", expiredAt),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEUserToken_withInvalidExpiry(t *testing.T) {
+	userID := testAccTFEUserTokenCurrentUserID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		CheckDestroy:             testAccCheckTFEUserTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFEUserToken_withExpiry(userID, "2000-01-01"),
+				ExpectError: regexp.MustCompile(`must be a valid date or time, provided in iso8601 format`),
+			},
+		},
+	})
+}
+
+// The tokens are created for the user the tests run as.
+func testAccTFEUserTokenCurrentUserID(t *testing.T) string {
+	client, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user, err := client.Users.ReadCurrent(context.Background())
+	if err != nil {
+		t.Fatalf("error reading the current user: %v", err)
+	}
+
+	return user.ID
+}
+
+func testAccCheckTFEUserTokenExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(ConfiguredClient)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := config.Client.UserTokens.Read(ctx, rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckTFEUserTokenDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(ConfiguredClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_user_token" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := config.Client.UserTokens.Read(ctx, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("User token %s still exists", rs.Primary.ID)
+		}
+		if !errors.Is(err, tfe.ErrResourceNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEUserToken_basic(userID string) string {
+	return fmt.Sprintf(`
+resource "tfe_user_token" "foobar" {
+  user_id     = "%s"
+  description = "tst-user-token"
+}`, userID)
+}
+
+func testAccTFEUserToken_withExpiry(userID, expiredAt string) string {
+	return fmt.Sprintf(`
+resource "tfe_user_token" "expiry" {
+  user_id     = "%s"
+  description = "tst-user-token-expiry"
+  expired_at  = "%s"
+}`, userID, expiredAt)
+}
@@ -171,7 +171,23 @@ func (r *resourceTFEVariable) Schema(ctx context.Context, req resource.SchemaReq
 					),
 				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							var stateSensitive types.Bool
+							var variableSetID types.String
+							resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("sensitive"), &stateSensitive)...)
+							resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("variable_set_id"), &variableSetID)...)
+							if resp.Diagnostics.HasError() {
+								return
+							}
+							// The category of variable set variables can't be updated.
+							if (stateSensitive.ValueBool() || !variableSetID.IsNull()) && req.PlanValue.ValueString() != req.StateValue.ValueString() {
+								resp.RequiresReplace = true
+							}
+						},
+						"Force replacement if category changed and sensitive is true, or the variable belongs to a variable set",
+						"Force replacement if category changed and sensitive is true, or the variable belongs to a variable set",
+					),
 				},
 			},
 			"description": schema.StringAttribute{
@@ -257,6 +273,29 @@ func isWorkspaceVariable(ctx context.Context, data AttrGettable) bool {
 	return !workspaceID.IsNull()
 }
 
+// ValidateConfig implements resource.ResourceWithValidateConfig
+func (r *resourceTFEVariable) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var key, category types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key"), &key)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("category"), &category)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Terraform variables are passed to runs as input variables directly, so
+	// the TF_VAR_ prefix is only meaningful for environment variables.
+	if category.ValueString() == string(tfe.CategoryTerraform) && strings.HasPrefix(key.ValueString(), "TF_VAR_") {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("key"),
+			"Unnecessary TF_VAR_ prefix",
+			fmt.Sprintf("The variable %q uses the TF_VAR_ prefix but has category \"terraform\". "+
+				"Terraform variables are set directly by their name, so this variable will be available as %q rather than %q. "+
+				"Remove the prefix, or use category \"env\" to set an environment variable.",
+				key.ValueString(), key.ValueString(), strings.TrimPrefix(key.ValueString(), "TF_VAR_")),
+		)
+	}
+}
+
 // Create implements resource.Resource
 func (r *resourceTFEVariable) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if isWorkspaceVariable(ctx, &req.Plan) {
@@ -449,6 +488,7 @@ func (r *resourceTFEVariable) updateWithWorkspace(ctx context.Context, req resou
 	// *sometimes* want to include Value.
 	options := tfe.VariableUpdateOptions{
 		Key:         plan.Key.ValueStringPointer(),
+		Category:    tfe.Category(tfe.CategoryType(plan.Category.ValueString())),
 		Description: plan.Description.ValueStringPointer(),
 		HCL:         plan.HCL.ValueBoolPointer(),
 		Sensitive:   plan.Sensitive.ValueBoolPointer(),
@@ -461,7 +501,7 @@ func (r *resourceTFEVariable) updateWithWorkspace(ctx context.Context, req resou
 	// our last-known value is a safe idempotent operation or not. This is why
 	// Terraform doesn't promise that it can manage drift at all for write-only
 	// attributes.)
-	if state.Value.ValueString() != plan.Value.ValueString() {
+	if variableValueChanged(state.Value, plan.Value) {
 		options.Value = plan.Value.ValueStringPointer()
 	}
 
@@ -480,6 +520,16 @@ func (r *resourceTFEVariable) updateWithWorkspace(ctx context.Context, req resou
 	resp.Diagnostics.Append(diags...)
 }
 
+// variableValueChanged reports whether the planned value of a variable differs
+// from its prior state, and therefore needs to be sent on update. An unknown
+// planned value is never sent, since it would clear the existing value.
+func variableValueChanged(stateValue, planValue types.String) bool {
+	if planValue.IsUnknown() {
+		return false
+	}
+	return stateValue.ValueString() != planValue.ValueString()
+}
+
 // updateWithVariableSet is the variable set version of Update.
 func (r *resourceTFEVariable) updateWithVariableSet(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get both plan and state; must compare them to handle sensitive values safely.
@@ -507,7 +557,7 @@ func (r *resourceTFEVariable) updateWithVariableSet(ctx context.Context, req res
 	}
 	// We ONLY want to set Value if our planned value would be a CHANGE from the
 	// prior state. See comments in updateWithWorkspace for more color.
-	if state.Value.ValueString() != plan.Value.ValueString() {
+	if variableValueChanged(state.Value, plan.Value) {
 		options.Value = plan.Value.ValueStringPointer()
 	}
 
@@ -754,6 +804,7 @@ func (u *updateReadableValuePlanModifier) PlanModifyString(ctx context.Context,
 // Compile-time interface check
 var _ resource.Resource = &resourceTFEVariable{}
 var _ resource.ResourceWithConfigure = &resourceTFEVariable{}
+var _ resource.ResourceWithValidateConfig = &resourceTFEVariable{}
 var _ resource.ResourceWithUpgradeState = &resourceTFEVariable{}
 var _ resource.ResourceWithImportState = &resourceTFEVariable{}
 var _ planmodifier.String = &updateReadableValuePlanModifier{}
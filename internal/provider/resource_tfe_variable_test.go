@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"regexp"
@@ -12,6 +13,10 @@ import (
 	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -191,6 +196,62 @@ func TestAccTFEVariable_update_key_sensitive(t *testing.T) {
 	})
 }
 
+func TestAccTFEVariable_update_category(t *testing.T) {
+	first := &tfe.Variable{}
+	second := &tfe.Variable{}
+	third := &tfe.Variable{}
+	fourth := &tfe.Variable{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		CheckDestroy:             testAccCheckTFEVariableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEVariable_category(rInt, "terraform", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEVariableExists(
+						"tfe_variable.foobar", first),
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "category", "terraform"),
+				),
+			},
+			// A non-sensitive variable is updated in place
+			{
+				Config: testAccTFEVariable_category(rInt, "env", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEVariableExists(
+						"tfe_variable.foobar", second),
+					testAccCheckTFEVariableIDsEqual(first, second),
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "category", "env"),
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "value", "value_test"),
+				),
+			},
+			{
+				Config: testAccTFEVariable_category(rInt, "env", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEVariableExists(
+						"tfe_variable.foobar", third),
+				),
+			},
+			// A sensitive variable is replaced
+			{
+				Config: testAccTFEVariable_category(rInt, "terraform", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEVariableExists(
+						"tfe_variable.foobar", fourth),
+					testAccCheckTFEVariableIDsNotEqual(third, fourth),
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "category", "terraform"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFEVariable_readable_value(t *testing.T) {
 	variable := &tfe.Variable{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -359,6 +420,41 @@ func TestAccTFEVariable_varset_readable_value_becomes_sensitive(t *testing.T) {
 	})
 }
 
+func TestVariableValueChanged(t *testing.T) {
+	cases := map[string]struct {
+		state    types.String
+		plan     types.String
+		expected bool
+	}{
+		"unchanged": {
+			state:    types.StringValue("foo"),
+			plan:     types.StringValue("foo"),
+			expected: false,
+		},
+		"changed": {
+			state:    types.StringValue("foo"),
+			plan:     types.StringValue("bar"),
+			expected: true,
+		},
+		"unknown plan": {
+			state:    types.StringValue("foo"),
+			plan:     types.StringUnknown(),
+			expected: false,
+		},
+		"cleared": {
+			state:    types.StringValue("foo"),
+			plan:     types.StringValue(""),
+			expected: true,
+		},
+	}
+
+	for name, c := range cases {
+		if got := variableValueChanged(c.state, c.plan); got != c.expected {
+			t.Errorf("%s: expected %t, got %t", name, c.expected, got)
+		}
+	}
+}
+
 func TestAccTFEVariable_import(t *testing.T) {
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
 
@@ -606,6 +702,17 @@ func testAccCheckTFEVariableIDsNotEqual(
 	}
 }
 
+func testAccCheckTFEVariableIDsEqual(
+	a, b *tfe.Variable) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if a.ID != b.ID {
+			return fmt.Errorf("Variables should have same ID: %s, %s", a.ID, b.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckTFEVariableDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(ConfiguredClient)
 
@@ -715,6 +822,27 @@ resource "tfe_variable" "foobar" {
 }`, rInt)
 }
 
+func testAccTFEVariable_category(rInt int, category string, sensitive bool) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_variable" "foobar" {
+  key          = "key_test"
+  value        = "value_test"
+  category     = "%s"
+  sensitive    = %t
+  workspace_id = tfe_workspace.foobar.id
+}`, rInt, category, sensitive)
+}
+
 func testAccTFEVariable_everything(rInt int) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
@@ -866,3 +994,61 @@ resource "tfe_workspace" "downstream-nonreadable" {
 }
 `, rIntOrg, rIntVariableValue, strconv.FormatBool(sensitive))
 }
+
+func TestTFEVariable_validateConfigTFVarPrefix(t *testing.T) {
+	testCases := map[string]struct {
+		key             string
+		category        string
+		expectedWarning bool
+	}{
+		"terraform variable with prefix": {
+			key:             "TF_VAR_region",
+			category:        "terraform",
+			expectedWarning: true,
+		},
+		"terraform variable without prefix": {
+			key:             "region",
+			category:        "terraform",
+			expectedWarning: false,
+		},
+		"env variable with prefix": {
+			key:             "TF_VAR_region",
+			category:        "env",
+			expectedWarning: false,
+		},
+	}
+
+	ctx := context.Background()
+	r := &resourceTFEVariable{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for attrName, attrType := range objectType.AttributeTypes {
+				values[attrName] = tftypes.NewValue(attrType, nil)
+			}
+			values["key"] = tftypes.NewValue(tftypes.String, tc.key)
+			values["category"] = tftypes.NewValue(tftypes.String, tc.category)
+
+			req := fwresource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(objectType, values),
+				},
+			}
+			resp := &fwresource.ValidateConfigResponse{}
+
+			r.ValidateConfig(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+			}
+			if hasWarning := resp.Diagnostics.WarningsCount() > 0; hasWarning != tc.expectedWarning {
+				t.Fatalf("expected warning: %t, got diagnostics: %v", tc.expectedWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
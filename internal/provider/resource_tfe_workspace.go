@@ -19,6 +19,7 @@ import (
 	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -28,10 +29,10 @@ var workspaceIDRegexp = regexp.MustCompile("^ws-[a-zA-Z0-9]{16}$")
 
 func resourceTFEWorkspace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEWorkspaceCreate,
-		Read:   resourceTFEWorkspaceRead,
-		Update: resourceTFEWorkspaceUpdate,
-		Delete: resourceTFEWorkspaceDelete,
+		CreateContext: resourceTFEWorkspaceCreate,
+		ReadContext:   resourceTFEWorkspaceRead,
+		UpdateContext: resourceTFEWorkspaceUpdate,
+		Delete:        resourceTFEWorkspaceDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEWorkspaceImporter,
 		},
@@ -62,6 +63,10 @@ func resourceTFEWorkspace() *schema.Resource {
 				return err
 			}
 
+			if err := customizeDiffIfProviderDefaultStructuredRunOutputChanged(c, d, meta); err != nil {
+				return err
+			}
+
 			return nil
 		},
 
@@ -81,6 +86,9 @@ func resourceTFEWorkspace() *schema.Resource {
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
+				DiffSuppressFunc: func(k, old, current string, d *schema.ResourceData) bool {
+					return equalIgnoringTrailingWhitespace(old, current)
+				},
 			},
 
 			"agent_pool_id": {
@@ -199,7 +207,7 @@ func resourceTFEWorkspace() *schema.Resource {
 			"structured_run_output_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				Default:  true,
+				Computed: true,
 			},
 
 			"tag_names": {
@@ -209,10 +217,17 @@ func resourceTFEWorkspace() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
-			"terraform_version": {
-				Type:     schema.TypeString,
+			"ignore_additional_tag_names": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				Computed: true,
+				Default:  false,
+			},
+
+			"terraform_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateTerraformVersion,
 			},
 
 			"trigger_prefixes": {
@@ -220,6 +235,7 @@ func resourceTFEWorkspace() *schema.Resource {
 				Optional:      true,
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				ConflictsWith: []string{"trigger_patterns"},
+				Deprecated:    "Use trigger_patterns instead, a prefix like \"modules/\" becomes the pattern \"modules/**/*\". This attribute will be removed in a future release of the provider.",
 			},
 
 			"trigger_patterns": {
@@ -268,6 +284,7 @@ func resourceTFEWorkspace() *schema.Resource {
 							Type:          schema.TypeString,
 							Optional:      true,
 							ConflictsWith: []string{"trigger_patterns", "trigger_prefixes"},
+							ValidateFunc:  validation.StringIsValidRegExp,
 						},
 
 						"github_app_installation_id": {
@@ -288,22 +305,50 @@ func resourceTFEWorkspace() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"last_remote_run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"variable_set_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"html_url": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"current_run": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
-func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create a new options struct.
@@ -321,6 +366,14 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 		WorkingDirectory:           tfe.String(d.Get("working_directory").(string)),
 	}
 
+	// Older Terraform Enterprise versions reject structured_run_output_enabled,
+	// so only send it when the server supports it.
+	var diags diag.Diagnostics
+	if !supportsStructuredRunOutput(config.Client.RemoteAPIVersion()) {
+		options.StructuredRunOutputEnabled = nil
+		diags = append(diags, structuredRunOutputDroppedDiagnostics(config.Client.RemoteAPIVersion())...)
+	}
+
 	// Send global_remote_state if it's set; otherwise, let it be computed.
 	globalRemoteState, ok := d.GetOkExists("global_remote_state")
 	if ok {
@@ -429,8 +482,8 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Create workspace %s for organization: %s", name, organization)
 	workspace, err := config.Client.Workspaces.Create(ctx, organization, options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error creating workspace %s for organization %s: %w", name, organization, err)
+		return diag.Errorf(
+			"Error creating workspace %s for organization %s: %v", name, organization, err)
 	}
 
 	d.SetId(workspace.ID)
@@ -440,7 +493,7 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 			SSHKeyID: tfe.String(sshKeyID.(string)),
 		})
 		if err != nil {
-			return fmt.Errorf("Error assigning SSH key to workspace %s: %w", name, err)
+			return diag.Errorf("Error assigning SSH key to workspace %s: %v", name, err)
 		}
 	}
 
@@ -452,26 +505,41 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 		}
 		err = config.Client.Workspaces.AddRemoteStateConsumers(ctx, workspace.ID, options)
 		if err != nil {
-			return fmt.Errorf("Error adding remote state consumers to workspace %s: %w", name, err)
+			return diag.Errorf("Error adding remote state consumers to workspace %s: %v", name, err)
 		}
 	}
 
-	return resourceTFEWorkspaceRead(d, meta)
+	return append(diags, resourceTFEWorkspaceRead(ctx, d, meta)...)
 }
 
-func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
+	var diags diag.Diagnostics
 
 	id := d.Id()
 	log.Printf("[DEBUG] Read configuration of workspace: %s", id)
-	workspace, err := config.Client.Workspaces.ReadByID(ctx, id)
+	workspace, err := config.Client.Workspaces.ReadByIDWithOptions(ctx, id, &tfe.WorkspaceReadOptions{
+		Include: []tfe.WSIncludeOpt{tfe.WSCurrentRun},
+	})
 	if err != nil {
 		if errors.Is(err, tfe.ErrResourceNotFound) {
 			log.Printf("[DEBUG] Workspace %s no longer exists", id)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of workspace %s: %w", id, err)
+		return diag.Errorf("Error reading configuration of workspace %s: %v", id, err)
+	}
+
+	// With auto apply, the next run applies on its own, so point out a
+	// failed current run that should be looked at first.
+	if workspace.AutoApply && workspace.CurrentRun != nil && runNeedsAttention(workspace.CurrentRun.Status) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Current run needs attention",
+			Detail: fmt.Sprintf(
+				"The current run %s of workspace %s is %s. The workspace auto-applies, so investigate the run before the next one is applied.",
+				workspace.CurrentRun.ID, id, workspace.CurrentRun.Status),
+		})
 	}
 
 	// Update the config.
@@ -497,9 +565,46 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("trigger_prefixes", workspace.TriggerPrefixes)
 	d.Set("trigger_patterns", workspace.TriggerPatterns)
 	d.Set("working_directory", workspace.WorkingDirectory)
+	// Workspaces are always read by ID, so a workspace that now belongs to a
+	// different organization is still found. Keep track of the new
+	// organization, which will show up as a change in the next plan.
+	if org, ok := d.GetOk("organization"); ok && org.(string) != workspace.Organization.Name {
+		log.Printf("[WARN] Workspace %s moved from organization %s to %s", id, org, workspace.Organization.Name)
+	}
 	d.Set("organization", workspace.Organization.Name)
 	d.Set("resource_count", workspace.ResourceCount)
 
+	var lastRemoteRunID string
+	var currentRun []interface{}
+	if workspace.CurrentRun != nil {
+		lastRemoteRunID = workspace.CurrentRun.ID
+		currentRun = append(currentRun, map[string]interface{}{
+			"id":         workspace.CurrentRun.ID,
+			"status":     string(workspace.CurrentRun.Status),
+			"created_at": workspace.CurrentRun.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	d.Set("last_remote_run_id", lastRemoteRunID)
+	d.Set("current_run", currentRun)
+
+	// Only the total count is needed, so don't page through the variable sets.
+	// Tokens that can't read variable sets can still manage the workspace, so
+	// keep the previous count instead of failing the read.
+	variableSets, err := config.Client.VariableSets.ListForWorkspace(ctx, id, &tfe.VariableSetListOptions{
+		ListOptions: tfe.ListOptions{PageSize: 1},
+	})
+	if err != nil {
+		log.Printf("[WARN] Error reading variable sets of workspace %s, leaving variable_set_count unchanged: %v", id, err)
+	} else {
+		var variableSetCount int
+		if variableSets.Pagination != nil {
+			variableSetCount = variableSets.TotalCount
+		} else {
+			variableSetCount = len(variableSets.Items)
+		}
+		d.Set("variable_set_count", variableSetCount)
+	}
+
 	if workspace.Links["self-html"] != nil {
 		baseAPI := config.Client.BaseURL()
 		htmlURL := url.URL{
@@ -529,13 +634,19 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("agent_pool_id", agentPoolID)
 
 	var tagNames []interface{}
-	for _, tagName := range workspace.TagNames {
-		tagNames = append(tagNames, tagName)
+	if d.Get("ignore_additional_tag_names").(bool) {
+		// Only keep the tags Terraform manages, so tags added outside of
+		// Terraform never show up as a diff that would remove them.
+		tagNames = managedTagNames(workspace.TagNames, d.Get("tag_names").(*schema.Set).List())
+	} else {
+		for _, tagName := range workspace.TagNames {
+			tagNames = append(tagNames, tagName)
+		}
 	}
 	d.Set("tag_names", tagNames)
 
 	var vcsRepo []interface{}
-	if workspace.VCSRepo != nil {
+	if hasVCSConnection(workspace.VCSRepo) {
 		vcsConfig := map[string]interface{}{
 			"identifier":                 workspace.VCSRepo.Identifier,
 			"branch":                     workspace.VCSRepo.Branch,
@@ -545,6 +656,15 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 			"tags_regex":                 workspace.VCSRepo.TagsRegex,
 		}
 		vcsRepo = append(vcsRepo, vcsConfig)
+	} else if workspace.VCSRepo != nil {
+		log.Printf("[WARN] The VCS connection of workspace %s no longer exists, removing vcs_repo from state", id)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "VCS connection no longer exists",
+			Detail: fmt.Sprintf(
+				"The VCS connection of workspace %s no longer exists, so vcs_repo was removed from state. "+
+					"Configure a new oauth_token_id or github_app_installation_id to reconnect the workspace.", id),
+		})
 	}
 
 	d.Set("vcs_repo", vcsRepo)
@@ -554,19 +674,20 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		globalRemoteState, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(id, config.Client)
 		if err != nil {
-			return fmt.Errorf(
-				"Error reading remote state consumers for workspace %s: %w", id, err)
+			return diag.Errorf(
+				"Error reading remote state consumers for workspace %s: %v", id, err)
 		}
 
 		d.Set("global_remote_state", globalRemoteState)
 		d.Set("remote_state_consumer_ids", remoteStateConsumerIDs)
 	}
 
-	return nil
+	return diags
 }
 
-func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
+	var diags diag.Diagnostics
 	id := d.Id()
 
 	if d.HasChange("name") || d.HasChange("auto_apply") || d.HasChange("auto_apply_run_trigger") || d.HasChange("queue_all_runs") ||
@@ -593,6 +714,11 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			WorkingDirectory:           tfe.String(d.Get("working_directory").(string)),
 		}
 
+		if !supportsStructuredRunOutput(config.Client.RemoteAPIVersion()) {
+			options.StructuredRunOutputEnabled = nil
+			diags = append(diags, structuredRunOutputDroppedDiagnostics(config.Client.RemoteAPIVersion())...)
+		}
+
 		if d.HasChange("project_id") {
 			if v, ok := d.GetOk("project_id"); ok && v.(string) != "" {
 				options.Project = &tfe.Project{ID: *tfe.String(v.(string))}
@@ -705,17 +831,18 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 				_, err := config.Client.Workspaces.RemoveVCSConnectionByID(ctx, id)
 				if err != nil {
 					d.Partial(true)
-					return fmt.Errorf("Error removing VCS repo from workspace %s: %w", id, err)
+					return diag.Errorf("Error removing VCS repo from workspace %s: %v", id, err)
 				}
 			}
 		}
 
 		log.Printf("[DEBUG] Update workspace %s", id)
-		_, err := config.Client.Workspaces.UpdateByID(ctx, id, options)
+		_, err := updateWorkspaceWithLockRetry(
+			ctx, config.Client.Workspaces, id, options, config.WorkspaceLockedUpdateRetries, workspaceLockedBackoff)
 		if err != nil {
 			d.Partial(true)
-			return fmt.Errorf(
-				"Error updating workspace %s: %w", id, err)
+			return diag.Errorf(
+				"Error updating workspace %s: %v", id, err)
 		}
 	}
 
@@ -731,12 +858,12 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 				},
 			)
 			if err != nil {
-				return fmt.Errorf("Error assigning SSH key to workspace %s: %w", id, err)
+				return diag.Errorf("Error assigning SSH key to workspace %s: %v", id, err)
 			}
 		} else {
 			_, err := config.Client.Workspaces.UnassignSSHKey(ctx, id)
 			if err != nil {
-				return fmt.Errorf("Error unassigning SSH key from workspace %s: %w", id, err)
+				return diag.Errorf("Error unassigning SSH key from workspace %s: %v", id, err)
 			}
 		}
 	}
@@ -761,7 +888,7 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Adding tags to workspace: %s", d.Id())
 			err := config.Client.Workspaces.AddTags(ctx, d.Id(), tfe.WorkspaceAddTagsOptions{Tags: addTags})
 			if err != nil {
-				return fmt.Errorf("Error adding tags to workspace %s: %w", d.Id(), err)
+				return diag.Errorf("Error adding tags to workspace %s: %v", d.Id(), err)
 			}
 		}
 
@@ -776,7 +903,7 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Removing tags from workspace: %s", d.Id())
 			err := config.Client.Workspaces.RemoveTags(ctx, d.Id(), tfe.WorkspaceRemoveTagsOptions{Tags: removeTags})
 			if err != nil {
-				return fmt.Errorf("Error removing tags from workspace %s: %w", d.Id(), err)
+				return diag.Errorf("Error removing tags from workspace %s: %v", d.Id(), err)
 			}
 		}
 	}
@@ -801,7 +928,7 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Adding remote state consumers to workspace: %s", d.Id())
 			err := config.Client.Workspaces.AddRemoteStateConsumers(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error adding remote state consumers to workspace %s: %w", d.Id(), err)
+				return diag.Errorf("Error adding remote state consumers to workspace %s: %v", d.Id(), err)
 			}
 		}
 
@@ -816,12 +943,12 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Removing remote state consumers from workspace: %s", d.Id())
 			err := config.Client.Workspaces.RemoveRemoteStateConsumers(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error removing remote state consumers from workspace %s: %w", d.Id(), err)
+				return diag.Errorf("Error removing remote state consumers from workspace %s: %v", d.Id(), err)
 			}
 		}
 	}
 
-	return resourceTFEWorkspaceRead(d, meta)
+	return append(diags, resourceTFEWorkspaceRead(ctx, d, meta)...)
 }
 
 func safeWorkspaceDelete(ctx context.Context, config ConfiguredClient, id string) error {
@@ -991,6 +1118,10 @@ func resourceTFEWorkspaceImporter(ctx context.Context, d *schema.ResourceData, m
 		d.SetId(workspaceID)
 	}
 
+	// Import doesn't apply schema defaults, so set the ones for arguments
+	// that only change provider behavior and can't be read from the API.
+	d.Set("ignore_additional_tag_names", false)
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// Polling intervals in milliseconds, see backoff().
+	workspaceOperationWaitBackoffMin = 1000
+	workspaceOperationWaitBackoffMax = 30000
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &resourceTFEWorkspaceOperationWait{}
+var _ resource.ResourceWithConfigure = &resourceTFEWorkspaceOperationWait{}
+var _ resource.ResourceWithModifyPlan = &resourceTFEWorkspaceOperationWait{}
+
+// workspaceActiveRunStatuses returns the statuses of runs that are still
+// being worked on or waiting for a decision, and so keep a workspace busy.
+func workspaceActiveRunStatuses() []string {
+	return []string{
+		string(tfe.RunPending),
+		string(tfe.RunFetching),
+		string(tfe.RunFetchingCompleted),
+		string(tfe.RunQueuing),
+		string(tfe.RunPlanQueued),
+		string(tfe.RunPrePlanRunning),
+		string(tfe.RunPrePlanCompleted),
+		string(tfe.RunPlanning),
+		string(tfe.RunPlanned),
+		string(tfe.RunCostEstimating),
+		string(tfe.RunCostEstimated),
+		string(tfe.RunPolicyChecking),
+		string(tfe.RunPolicyChecked),
+		string(tfe.RunPolicyOverride),
+		string(tfe.RunPostPlanRunning),
+		string(tfe.RunPostPlanCompleted),
+		string(tfe.RunPostPlanAwaitingDecision),
+		string(tfe.RunConfirmed),
+		string(tfe.RunPreApplyRunning),
+		string(tfe.RunPreApplyCompleted),
+		string(tfe.RunQueuingApply),
+		string(tfe.RunApplyQueued),
+		string(tfe.RunApplying),
+	}
+}
+
+func NewWorkspaceOperationWaitResource() resource.Resource {
+	return &resourceTFEWorkspaceOperationWait{}
+}
+
+// resourceTFEWorkspaceOperationWait implements the
+// tfe_workspace_operation_wait resource type
+type resourceTFEWorkspaceOperationWait struct {
+	config ConfiguredClient
+}
+
+type modelTFEWorkspaceOperationWait struct {
+	ID             types.String `tfsdk:"id"`
+	WorkspaceID    types.String `tfsdk:"workspace_id"`
+	TimeoutSeconds types.Int64  `tfsdk:"timeout_seconds"`
+	LastRunID      types.String `tfsdk:"last_run_id"`
+}
+
+func (r *resourceTFEWorkspaceOperationWait) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_operation_wait"
+}
+
+func (r *resourceTFEWorkspaceOperationWait) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Waits until a workspace has no active runs. The resource is replaced on every apply, so it waits again each time.",
+		Version:     0,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the workspace.",
+				Computed:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "The id of the workspace to wait for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						IDPattern("ws"),
+						"must be a valid workspace ID (ws-<RANDOM STRING>)",
+					),
+				},
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "How long to wait for the workspace to become idle before failing. Defaults to 600.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(600),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"last_run_id": schema.StringAttribute{
+				Description: "The ID of the workspace's most recent run once it became idle, if any.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *resourceTFEWorkspaceOperationWait) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected resource Configure type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+	}
+	r.config = client
+}
+
+// ModifyPlan replaces the resource whenever it already exists, so every apply
+// waits for the workspace again.
+func (r *resourceTFEWorkspaceOperationWait) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_run_id"), types.StringUnknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("last_run_id"))
+}
+
+func (r *resourceTFEWorkspaceOperationWait) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan modelTFEWorkspaceOperationWait
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := plan.WorkspaceID.ValueString()
+	timeout := time.Duration(plan.TimeoutSeconds.ValueInt64()) * time.Second
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	options := &tfe.RunListOptions{
+		ListOptions: tfe.ListOptions{PageSize: 1},
+		Status:      strings.Join(workspaceActiveRunStatuses(), ","),
+	}
+
+	for i := 0; ; i++ {
+		tflog.Debug(ctx, "Listing active runs", map[string]interface{}{"workspace_id": workspaceID})
+		runs, err := r.config.Client.Runs.List(waitCtx, workspaceID, options)
+		if err != nil {
+			if waitCtx.Err() != nil {
+				resp.Diagnostics.AddError(
+					"Timed out waiting for workspace",
+					fmt.Sprintf("Workspace %s still had active runs after %s", workspaceID, timeout),
+				)
+				return
+			}
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to list runs of workspace %s", workspaceID), err.Error())
+			return
+		}
+
+		if len(runs.Items) == 0 {
+			break
+		}
+
+		tflog.Debug(ctx, "Workspace has active runs", map[string]interface{}{"workspace_id": workspaceID, "run_id": runs.Items[0].ID, "status": runs.Items[0].Status})
+		select {
+		case <-waitCtx.Done():
+			resp.Diagnostics.AddError(
+				"Timed out waiting for workspace",
+				fmt.Sprintf("Workspace %s still had active runs after %s, the latest one is %s (%s)", workspaceID, timeout, runs.Items[0].ID, runs.Items[0].Status),
+			)
+			return
+		case <-time.After(backoff(workspaceOperationWaitBackoffMin, workspaceOperationWaitBackoffMax, i)):
+		}
+	}
+
+	tflog.Debug(ctx, "Reading workspace", map[string]interface{}{"workspace_id": workspaceID})
+	ws, err := r.config.Client.Workspaces.ReadByID(ctx, workspaceID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to read workspace %s", workspaceID), err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(ws.ID)
+	plan.LastRunID = types.StringNull()
+	if ws.CurrentRun != nil {
+		plan.LastRunID = types.StringValue(ws.CurrentRun.ID)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the recorded state, since it describes a point in time rather
+// than a remote object. It only drops the resource when the workspace is gone.
+func (r *resourceTFEWorkspaceOperationWait) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state modelTFEWorkspaceOperationWait
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := state.WorkspaceID.ValueString()
+
+	tflog.Debug(ctx, "Reading workspace", map[string]interface{}{"workspace_id": workspaceID})
+	_, err := r.config.Client.Workspaces.ReadByID(ctx, workspaceID)
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			tflog.Debug(ctx, "Workspace no longer exists", map[string]interface{}{"workspace_id": workspaceID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to read workspace %s", workspaceID), err.Error())
+	}
+}
+
+// Update is never called, since every change replaces the resource.
+func (r *resourceTFEWorkspaceOperationWait) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Unexpected update",
+		"tfe_workspace_operation_wait can't be updated in place. This is a bug in the tfe provider, so please report it on GitHub.",
+	)
+}
+
+// Delete only removes the resource from state, there is nothing to clean up.
+func (r *resourceTFEWorkspaceOperationWait) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEWorkspaceOperationWait_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceOperationWait_basic(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"tfe_workspace_operation_wait.foobar", "id",
+						"tfe_workspace.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_operation_wait.foobar", "timeout_seconds", "60"),
+					resource.TestCheckNoResourceAttr(
+						"tfe_workspace_operation_wait.foobar", "last_run_id"),
+				),
+				// The resource is replaced on every apply.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTFEWorkspaceOperationWait_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace_operation_wait" "foobar" {
+  workspace_id    = tfe_workspace.foobar.id
+  timeout_seconds = 60
+}`, rInt)
+}
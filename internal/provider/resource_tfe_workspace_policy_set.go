@@ -112,6 +112,12 @@ func resourceTFEWorkspacePolicySetDelete(d *schema.ResourceData, meta interface{
 
 	err := config.Client.PolicySets.RemoveWorkspaces(ctx, policySetID, policySetRemoveWorkspacesOptions)
 	if err != nil {
+		// Either the policy set or the workspace may already have been deleted,
+		// in which case there is nothing left to detach.
+		if errors.Is(err, tfe.ErrResourceNotFound) {
+			log.Printf("[DEBUG] Policy set %s or workspace %s no longer exists", policySetID, workspaceID)
+			return nil
+		}
 		return fmt.Errorf(
 			"Error detaching workspace %s from policy set %s: %w", workspaceID, policySetID, err)
 	}
@@ -112,6 +112,12 @@ func resourceTFEWorkspacePolicySetExclusionDelete(d *schema.ResourceData, meta i
 
 	err := config.Client.PolicySets.RemoveWorkspaceExclusions(ctx, policySetID, policySetRemoveWorkspaceExclusionsOptions)
 	if err != nil {
+		if errors.Is(err, tfe.ErrResourceNotFound) {
+			// The policy set or the workspace is already gone, and the
+			// exclusion with it.
+			log.Printf("[DEBUG] Policy set %s or workspace %s no longer exists", policySetID, workspaceExclusionsID)
+			return nil
+		}
 		return fmt.Errorf(
 			"error removing excluded workspace %s from policy set %s: %w", workspaceExclusionsID, policySetID, err)
 	}
@@ -48,6 +48,36 @@ func TestAccTFEWorkspacePolicySetExclusion_basic(t *testing.T) {
 	})
 }
 
+func TestAccTFEWorkspacePolicySetExclusion_global(t *testing.T) {
+	skipUnlessBeta(t)
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspacePolicySetExclusionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspacePolicySetExclusion_global(org.Name, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.test", "global", "true"),
+					testAccCheckTFEWorkspacePolicySetExclusionExists(
+						"tfe_workspace_policy_set_exclusion.test"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFEWorkspacePolicySetExclusion_incorrectImportSyntax(t *testing.T) {
 	skipUnlessBeta(t)
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -156,3 +186,23 @@ func testAccTFEWorkspacePolicySetExclusion_basic(orgName string, rInt int) strin
 		workspace_id  = tfe_workspace.test.id
 	}`, rInt, orgName, rInt, orgName)
 }
+
+func testAccTFEWorkspacePolicySetExclusion_global(orgName string, rInt int) string {
+	return fmt.Sprintf(`
+	resource "tfe_workspace" "test" {
+		name         = "tst-terraform-%d"
+		organization = "%s"
+	}
+
+	resource "tfe_policy_set" "test" {
+		name         = "tst-policy-set-%d"
+		description  = "Global Policy Set"
+		organization = "%s"
+		global       = true
+	}
+
+	resource "tfe_workspace_policy_set_exclusion" "test" {
+		policy_set_id = tfe_policy_set.test.id
+		workspace_id  = tfe_workspace.test.id
+	}`, rInt, orgName, rInt, orgName)
+}
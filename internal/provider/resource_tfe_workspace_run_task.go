@@ -184,7 +184,7 @@ func resourceTFEWorkspaceRunTaskUpdate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error updating task %s in workspace %s: %w", d.Id(), workspaceID, err)
 	}
 
-	return nil
+	return resourceTFEWorkspaceRunTaskRead(d, meta)
 }
 
 func resourceTFEWorkspaceRunTaskRead(d *schema.ResourceData, meta interface{}) error {
@@ -0,0 +1,407 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &resourceTFEWorkspaceRunTaskBulkAssignment{}
+var _ resource.ResourceWithConfigure = &resourceTFEWorkspaceRunTaskBulkAssignment{}
+var _ resource.ResourceWithImportState = &resourceTFEWorkspaceRunTaskBulkAssignment{}
+var _ resource.ResourceWithValidateConfig = &resourceTFEWorkspaceRunTaskBulkAssignment{}
+
+// workspaceRunTaskAssignmentElementType is the object type definition for
+// the tasks field schema.
+var workspaceRunTaskAssignmentElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"task_id":           types.StringType,
+		"enforcement_level": types.StringType,
+		"stage":             types.StringType,
+	},
+}
+
+func NewWorkspaceRunTaskBulkAssignmentResource() resource.Resource {
+	return &resourceTFEWorkspaceRunTaskBulkAssignment{}
+}
+
+// resourceTFEWorkspaceRunTaskBulkAssignment implements the
+// tfe_workspace_run_task_bulk_assignment resource type
+type resourceTFEWorkspaceRunTaskBulkAssignment struct {
+	config ConfiguredClient
+}
+
+type modelTFEWorkspaceRunTaskBulkAssignment struct {
+	ID          types.String `tfsdk:"id"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+	Tasks       types.Set    `tfsdk:"tasks"`
+}
+
+type modelTFEWorkspaceRunTaskAssignment struct {
+	TaskID           types.String `tfsdk:"task_id"`
+	EnforcementLevel types.String `tfsdk:"enforcement_level"`
+	Stage            types.String `tfsdk:"stage"`
+}
+
+func (r *resourceTFEWorkspaceRunTaskBulkAssignment) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_run_task_bulk_assignment"
+}
+
+func (r *resourceTFEWorkspaceRunTaskBulkAssignment) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages all of the run tasks attached to a workspace.",
+		Version:     0,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the workspace.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "The id of the workspace to attach the run tasks to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						IDPattern("ws"),
+						"must be a valid workspace ID (ws-<RANDOM STRING>)",
+					),
+				},
+			},
+			// SetAttribute is used here because we are still using plugin
+			// protocol v5, which does not support nested attributes.
+			"tasks": schema.SetAttribute{
+				Description: fmt.Sprintf(
+					"The run tasks attached to the workspace. Valid enforcement levels are %s. Valid stages are %s.",
+					sentenceList(workspaceRunTaskEnforcementLevels(), "`", "`", "and"),
+					sentenceList(workspaceRunTaskStages(), "`", "`", "and"),
+				),
+				Required:    true,
+				ElementType: workspaceRunTaskAssignmentElementType,
+			},
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *resourceTFEWorkspaceRunTaskBulkAssignment) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected resource Configure type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+	}
+	r.config = client
+}
+
+// ValidateConfig checks the enforcement level and stage of every task, and
+// that each run task is only attached once.
+func (r *resourceTFEWorkspaceRunTaskBulkAssignment) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var tasks types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tasks"), &tasks)...)
+	if resp.Diagnostics.HasError() || tasks.IsNull() || tasks.IsUnknown() {
+		return
+	}
+
+	var assignments []modelTFEWorkspaceRunTaskAssignment
+	resp.Diagnostics.Append(tasks.ElementsAs(ctx, &assignments, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool)
+	for _, a := range assignments {
+		if !a.EnforcementLevel.IsUnknown() && !slices.Contains(workspaceRunTaskEnforcementLevels(), a.EnforcementLevel.ValueString()) {
+			resp.Diagnostics.AddAttributeError(path.Root("tasks"), "Invalid enforcement_level", fmt.Sprintf(
+				"enforcement_level must be one of %s, got: %q",
+				sentenceList(workspaceRunTaskEnforcementLevels(), "", "", "or"), a.EnforcementLevel.ValueString(),
+			))
+		}
+
+		if !a.Stage.IsUnknown() && !slices.Contains(workspaceRunTaskStages(), a.Stage.ValueString()) {
+			resp.Diagnostics.AddAttributeError(path.Root("tasks"), "Invalid stage", fmt.Sprintf(
+				"stage must be one of %s, got: %q",
+				sentenceList(workspaceRunTaskStages(), "", "", "or"), a.Stage.ValueString(),
+			))
+		}
+
+		if a.TaskID.IsUnknown() {
+			continue
+		}
+		if seen[a.TaskID.ValueString()] {
+			resp.Diagnostics.AddAttributeError(path.Root("tasks"), "Duplicate run task", fmt.Sprintf(
+				"run task %s can only be attached to a workspace once", a.TaskID.ValueString(),
+			))
+		}
+		seen[a.TaskID.ValueString()] = true
+	}
+}
+
+func (r *resourceTFEWorkspaceRunTaskBulkAssignment) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan modelTFEWorkspaceRunTaskBulkAssignment
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := plan.WorkspaceID.ValueString()
+	if err := r.syncAssignments(ctx, workspaceID, plan.Tasks); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to attach run tasks to workspace %s", workspaceID), err.Error())
+		return
+	}
+
+	result, err := r.readAssignments(ctx, workspaceID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to read run tasks of workspace %s", workspaceID), err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+func (r *resourceTFEWorkspaceRunTaskBulkAssignment) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state modelTFEWorkspaceRunTaskBulkAssignment
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := state.WorkspaceID.ValueString()
+	result, err := r.readAssignments(ctx, workspaceID)
+	if err != nil {
+		if errors.Is(err, tfe.ErrResourceNotFound) {
+			tflog.Debug(ctx, "Workspace no longer exists", map[string]interface{}{"workspace_id": workspaceID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to read run tasks of workspace %s", workspaceID), err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+func (r *resourceTFEWorkspaceRunTaskBulkAssignment) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan modelTFEWorkspaceRunTaskBulkAssignment
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := plan.WorkspaceID.ValueString()
+	if err := r.syncAssignments(ctx, workspaceID, plan.Tasks); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to update run tasks of workspace %s", workspaceID), err.Error())
+		return
+	}
+
+	result, err := r.readAssignments(ctx, workspaceID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to read run tasks of workspace %s", workspaceID), err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+func (r *resourceTFEWorkspaceRunTaskBulkAssignment) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state modelTFEWorkspaceRunTaskBulkAssignment
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := state.WorkspaceID.ValueString()
+	if err := r.syncAssignments(ctx, workspaceID, types.SetValueMust(workspaceRunTaskAssignmentElementType, nil)); err != nil {
+		if errors.Is(err, tfe.ErrResourceNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to detach run tasks from workspace %s", workspaceID), err.Error())
+	}
+}
+
+func (r *resourceTFEWorkspaceRunTaskBulkAssignment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !isResourceIDFormat("ws", req.ID) {
+		resp.Diagnostics.AddError("Error importing workspace run task bulk assignment", fmt.Sprintf(
+			"invalid workspace input format: %s (expected <WORKSPACE ID>)",
+			req.ID,
+		))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), req.ID)...)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// listWorkspaceRunTasks returns every run task attached to the workspace.
+func (r *resourceTFEWorkspaceRunTaskBulkAssignment) listWorkspaceRunTasks(ctx context.Context, workspaceID string) ([]*tfe.WorkspaceRunTask, error) {
+	var result []*tfe.WorkspaceRunTask
+
+	options := &tfe.WorkspaceRunTaskListOptions{}
+	for {
+		tflog.Debug(ctx, "Listing workspace run tasks", map[string]interface{}{"workspace_id": workspaceID})
+		list, err := r.config.Client.WorkspaceRunTasks.List(ctx, workspaceID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, list.Items...)
+
+		// Exit the loop when we've seen all pages.
+		if list.Pagination == nil || list.CurrentPage >= list.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = list.NextPage
+	}
+
+	return result, nil
+}
+
+// readAssignments builds the resource model from the run tasks currently
+// attached to the workspace.
+func (r *resourceTFEWorkspaceRunTaskBulkAssignment) readAssignments(ctx context.Context, workspaceID string) (*modelTFEWorkspaceRunTaskBulkAssignment, error) {
+	wstasks, err := r.listWorkspaceRunTasks(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := make([]modelTFEWorkspaceRunTaskAssignment, 0, len(wstasks))
+	for _, wstask := range wstasks {
+		if wstask == nil || wstask.RunTask == nil {
+			continue
+		}
+		assignments = append(assignments, modelTFEWorkspaceRunTaskAssignment{
+			TaskID:           types.StringValue(wstask.RunTask.ID),
+			EnforcementLevel: types.StringValue(string(wstask.EnforcementLevel)),
+			Stage:            types.StringValue(string(wstask.Stage)),
+		})
+	}
+
+	tasks, diags := types.SetValueFrom(ctx, workspaceRunTaskAssignmentElementType, assignments)
+	if diags.HasError() {
+		return nil, fmt.Errorf("could not build set value from run task assignments")
+	}
+
+	return &modelTFEWorkspaceRunTaskBulkAssignment{
+		ID:          types.StringValue(workspaceID),
+		WorkspaceID: types.StringValue(workspaceID),
+		Tasks:       tasks,
+	}, nil
+}
+
+// syncAssignments makes the run tasks attached to the workspace match the
+// desired set: run tasks missing from the set are detached, existing ones are
+// updated in place and new ones are attached.
+func (r *resourceTFEWorkspaceRunTaskBulkAssignment) syncAssignments(ctx context.Context, workspaceID string, tasks types.Set) error {
+	var desired []modelTFEWorkspaceRunTaskAssignment
+	if diags := tasks.ElementsAs(ctx, &desired, false); diags.HasError() {
+		return fmt.Errorf("could not read run task assignments from plan")
+	}
+
+	current, err := r.listWorkspaceRunTasks(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]*tfe.WorkspaceRunTask, len(current))
+	for _, wstask := range current {
+		if wstask != nil && wstask.RunTask != nil {
+			existing[wstask.RunTask.ID] = wstask
+		}
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	for _, a := range desired {
+		wanted[a.TaskID.ValueString()] = true
+	}
+
+	for taskID, wstask := range existing {
+		if wanted[taskID] {
+			continue
+		}
+
+		tflog.Debug(ctx, "Detaching run task from workspace", map[string]interface{}{"workspace_id": workspaceID, "task_id": taskID})
+		err := r.config.Client.WorkspaceRunTasks.Delete(ctx, workspaceID, wstask.ID)
+		if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
+			return fmt.Errorf("error detaching run task %s: %w", taskID, err)
+		}
+	}
+
+	for _, a := range desired {
+		taskID := a.TaskID.ValueString()
+		level := tfe.TaskEnforcementLevel(a.EnforcementLevel.ValueString())
+		stage := tfe.Stage(a.Stage.ValueString())
+
+		if wstask, ok := existing[taskID]; ok {
+			if wstask.EnforcementLevel == level && wstask.Stage == stage {
+				continue
+			}
+
+			tflog.Debug(ctx, "Updating run task in workspace", map[string]interface{}{"workspace_id": workspaceID, "task_id": taskID})
+			_, err := r.config.Client.WorkspaceRunTasks.Update(ctx, workspaceID, wstask.ID, tfe.WorkspaceRunTaskUpdateOptions{
+				EnforcementLevel: level,
+				Stage:            &stage,
+			})
+			if err != nil {
+				return fmt.Errorf("error updating run task %s: %w", taskID, err)
+			}
+			continue
+		}
+
+		tflog.Debug(ctx, "Attaching run task to workspace", map[string]interface{}{"workspace_id": workspaceID, "task_id": taskID})
+		_, err := r.config.Client.WorkspaceRunTasks.Create(ctx, workspaceID, tfe.WorkspaceRunTaskCreateOptions{
+			RunTask:          &tfe.RunTask{ID: taskID},
+			EnforcementLevel: level,
+			Stage:            &stage,
+		})
+		if err != nil {
+			return fmt.Errorf("error attaching run task %s: %w", taskID, err)
+		}
+	}
+
+	return nil
+}
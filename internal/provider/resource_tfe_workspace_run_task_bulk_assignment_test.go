@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEWorkspaceRunTaskBulkAssignment_basic(t *testing.T) {
+	skipUnlessRunTasksDefined(t)
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		CheckDestroy:             testAccCheckTFEWorkspaceRunTaskBulkAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceRunTaskBulkAssignment_basic(org.Name, runTasksURL()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"tfe_workspace_run_task_bulk_assignment.foobar", "id",
+						"tfe_workspace.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_run_task_bulk_assignment.foobar", "tasks.#", "2"),
+					testAccCheckTFEWorkspaceRunTaskCount("tfe_workspace.foobar", 2),
+				),
+			},
+			{
+				Config: testAccTFEWorkspaceRunTaskBulkAssignment_update(org.Name, runTasksURL()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_run_task_bulk_assignment.foobar", "tasks.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"tfe_workspace_run_task_bulk_assignment.foobar", "tasks.*", map[string]string{
+							"enforcement_level": "mandatory",
+							"stage":             "pre_apply",
+						}),
+					testAccCheckTFEWorkspaceRunTaskCount("tfe_workspace.foobar", 1),
+				),
+			},
+			{
+				ResourceName:      "tfe_workspace_run_task_bulk_assignment.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTFEWorkspaceRunTaskBulkAssignment_invalidStage(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "tfe_workspace_run_task_bulk_assignment" "foobar" {
+  workspace_id = "ws-AAAAAAAAAAAAAAAA"
+  tasks = [
+    {
+      task_id           = "task-AAAAAAAAAAAAAAAA"
+      enforcement_level = "advisory"
+      stage             = "post_apply"
+    },
+  ]
+}`,
+				ExpectError: regexp.MustCompile(`stage must be one of`),
+			},
+		},
+	})
+}
+
+func testAccCheckTFEWorkspaceRunTaskCount(workspaceResource string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(ConfiguredClient)
+
+		rs, ok := s.RootModule().Resources[workspaceResource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", workspaceResource)
+		}
+
+		list, err := config.Client.WorkspaceRunTasks.List(ctx, rs.Primary.ID, nil)
+		if err != nil {
+			return fmt.Errorf("error listing workspace run tasks: %w", err)
+		}
+
+		if len(list.Items) != expected {
+			return fmt.Errorf("expected %d workspace run tasks, got %d", expected, len(list.Items))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTFEWorkspaceRunTaskBulkAssignmentDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(ConfiguredClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_workspace_run_task_bulk_assignment" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		list, err := config.Client.WorkspaceRunTasks.List(ctx, rs.Primary.ID, nil)
+		if err != nil {
+			// The workspace itself may already be gone.
+			continue
+		}
+		if len(list.Items) > 0 {
+			return fmt.Errorf("Workspace %s still has %d run tasks attached", rs.Primary.ID, len(list.Items))
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEWorkspaceRunTaskBulkAssignment_basic(orgName, runTaskURL string) string {
+	return fmt.Sprintf(`
+locals {
+  organization_name = "%s"
+}
+
+resource "tfe_organization_run_task" "foo" {
+  organization = local.organization_name
+  url          = "%s"
+  name         = "foo-task"
+}
+
+resource "tfe_organization_run_task" "bar" {
+  organization = local.organization_name
+  url          = "%s"
+  name         = "bar-task"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = local.organization_name
+}
+
+resource "tfe_workspace_run_task_bulk_assignment" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+
+  tasks = [
+    {
+      task_id           = tfe_organization_run_task.foo.id
+      enforcement_level = "advisory"
+      stage             = "post_plan"
+    },
+    {
+      task_id           = tfe_organization_run_task.bar.id
+      enforcement_level = "advisory"
+      stage             = "post_plan"
+    },
+  ]
+}
+`, orgName, runTaskURL, runTaskURL)
+}
+
+func testAccTFEWorkspaceRunTaskBulkAssignment_update(orgName, runTaskURL string) string {
+	return fmt.Sprintf(`
+locals {
+  organization_name = "%s"
+}
+
+resource "tfe_organization_run_task" "foo" {
+  organization = local.organization_name
+  url          = "%s"
+  name         = "foo-task"
+}
+
+resource "tfe_organization_run_task" "bar" {
+  organization = local.organization_name
+  url          = "%s"
+  name         = "bar-task"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = local.organization_name
+}
+
+resource "tfe_workspace_run_task_bulk_assignment" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+
+  tasks = [
+    {
+      task_id           = tfe_organization_run_task.foo.id
+      enforcement_level = "mandatory"
+      stage             = "pre_apply"
+    },
+  ]
+}
+`, orgName, runTaskURL, runTaskURL)
+}
@@ -71,6 +71,12 @@ func TestAccTFEWorkspace_basic(t *testing.T) {
 						"tfe_workspace.foobar", "working_directory", ""),
 					resource.TestCheckResourceAttr(
 						"tfe_workspace.foobar", "resource_count", "0"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "last_remote_run_id", ""),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "current_run.#", "0"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "variable_set_count", "0"),
 					resource.TestCheckResourceAttr(
 						"tfe_workspace.foobar", "html_url", fmt.Sprintf("https://%s/app/%s/workspaces/%s", os.Getenv("TFE_HOSTNAME"), orgName, workspaceName)),
 				),
@@ -147,8 +153,10 @@ func TestAccTFEWorkspaceProviderDefaultOrgChanged(t *testing.T) {
 					providers["tfe"].ConfigureContextFunc = func(ctx context.Context, rd *schema.ResourceData) (interface{}, diag.Diagnostics) {
 						client, err := getClientUsingEnv()
 						return ConfiguredClient{
-							Client:       client,
-							Organization: anotherOrg.Name,
+							Client:                            client,
+							Organization:                      anotherOrg.Name,
+							DefaultStructuredRunOutputEnabled: defaultStructuredRunOutputEnabled,
+							WorkspaceLockedUpdateRetries:      workspaceLockedRetryDefault,
 						}, diag.FromErr(err)
 					}
 				},
@@ -1343,6 +1351,62 @@ func TestAccTFEWorkspace_changeTags(t *testing.T) {
 	})
 }
 
+func TestAccTFEWorkspace_ignoreAdditionalTagNames(t *testing.T) {
+	workspace := &tfe.Workspace{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspace_ignoreAdditionalTagNames(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace, testAccProvider),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "ignore_additional_tag_names", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "tag_names.#", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "tag_names.0", "prod"),
+				),
+			},
+			{
+				// Tag the workspace outside of Terraform, which must not
+				// cause a diff or be removed.
+				PreConfig: func() {
+					config := testAccProvider.Meta().(ConfiguredClient)
+					err := config.Client.Workspaces.AddTags(ctx, workspace.ID, tfe.WorkspaceAddTagsOptions{
+						Tags: []*tfe.Tag{{Name: "unmanaged"}},
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testAccTFEWorkspace_ignoreAdditionalTagNames(rInt),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccTFEWorkspace_ignoreAdditionalTagNames(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace, testAccProvider),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "tag_names.#", "1"),
+					func(s *terraform.State) error {
+						if len(workspace.TagNames) != 2 {
+							return fmt.Errorf("expected the workspace to keep 2 tags, got %v", workspace.TagNames)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFEWorkspace_updateSpeculative(t *testing.T) {
 	workspace := &tfe.Workspace{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -1407,6 +1471,49 @@ func TestAccTFEWorkspace_structuredRunOutputDisabled(t *testing.T) {
 	})
 }
 
+func TestAccTFEWorkspace_providerDefaultStructuredRunOutput(t *testing.T) {
+	workspace := &tfe.Workspace{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			// The workspace inherits the provider default
+			{
+				Config: testAccTFEWorkspace_providerDefaultStructuredRunOutput(rInt, "false", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace, testAccProvider),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "structured_run_output_enabled", "false"),
+				),
+			},
+			// The workspace overrides the provider default
+			{
+				Config: testAccTFEWorkspace_providerDefaultStructuredRunOutput(rInt, "false", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace, testAccProvider),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "structured_run_output_enabled", "true"),
+				),
+			},
+			// Without a provider default, structured run output is enabled
+			{
+				Config: testAccTFEWorkspace_providerDefaultStructuredRunOutput(rInt, "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace, testAccProvider),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "structured_run_output_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFEWorkspace_updateVCSRepo(t *testing.T) {
 	workspace := &tfe.Workspace{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -1711,6 +1818,105 @@ func TestAccTFEWorkspace_updateRemoveVCSRepoWithTagsRegex(t *testing.T) {
 	})
 }
 
+func TestAccTFEWorkspace_vcsTriggerCombinations(t *testing.T) {
+	workspace := &tfe.Workspace{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccGithubPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			// A branch with trigger prefixes
+			{
+				Config: testAccTFEWorkspace_vcsTriggersConfigurationGenerator(
+					rInt, true, `["/prefix1", "/prefix2"]`, "", "",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists("tfe_workspace.foobar", workspace, testAccProvider),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "file_triggers_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "trigger_prefixes.#", "2"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "trigger_patterns.#", "0"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "vcs_repo.0.branch", envGithubWorkspaceBranch),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "vcs_repo.0.tags_regex", ""),
+				),
+			},
+			// A branch with trigger patterns
+			{
+				Config: testAccTFEWorkspace_vcsTriggersConfigurationGenerator(
+					rInt, true, "", `["foo/**/*"]`, "",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists("tfe_workspace.foobar", workspace, testAccProvider),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "file_triggers_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "trigger_prefixes.#", "0"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "trigger_patterns.#", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "trigger_patterns.0", "foo/**/*"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "vcs_repo.0.branch", envGithubWorkspaceBranch),
+				),
+			},
+			// A tags regex without file triggers
+			{
+				Config: testAccTFEWorkspace_vcsTriggersConfigurationGenerator(
+					rInt, false, "", "", `\\d+.\\d+.\\d+`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists("tfe_workspace.foobar", workspace, testAccProvider),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "file_triggers_enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "trigger_prefixes.#", "0"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "trigger_patterns.#", "0"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "vcs_repo.0.tags_regex", `\d+.\d+.\d+`),
+				),
+			},
+			// A tags regex must compile
+			{
+				Config: testAccTFEWorkspace_vcsTriggersConfigurationGenerator(
+					rInt, false, "", "", `v(`,
+				),
+				ExpectError: regexp.MustCompile(`error parsing regexp`),
+			},
+			// Trigger prefixes and patterns conflict
+			{
+				Config: testAccTFEWorkspace_vcsTriggersConfigurationGenerator(
+					rInt, true, `["/prefix"]`, `["pattern"]`, "",
+				),
+				ExpectError: regexp.MustCompile(`Conflicting configuration`),
+			},
+			// A tags regex conflicts with trigger patterns
+			{
+				Config: testAccTFEWorkspace_vcsTriggersConfigurationGenerator(
+					rInt, true, "", `["pattern"]`, `\\d+.\\d+.\\d+`,
+				),
+				ExpectError: regexp.MustCompile(`Conflicting configuration`),
+			},
+			// A tags regex conflicts with trigger prefixes
+			{
+				Config: testAccTFEWorkspace_vcsTriggersConfigurationGenerator(
+					rInt, true, `["/prefix"]`, "", `\\d+.\\d+.\\d+`,
+				),
+				ExpectError: regexp.MustCompile(`Conflicting configuration`),
+			},
+		},
+	})
+}
+
 func TestAccTFEWorkspace_sshKey(t *testing.T) {
 	workspace := &tfe.Workspace{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -1768,14 +1974,14 @@ func TestAccTFEWorkspace_import(t *testing.T) {
 				ResourceName:            "tfe_workspace.foobar",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_delete"},
+				ImportStateVerifyIgnore: []string{"force_delete", "ignore_additional_tag_names"},
 			},
 			{
 				ResourceName:            "tfe_workspace.foobar",
 				ImportState:             true,
 				ImportStateId:           fmt.Sprintf("tst-terraform-%d/workspace-test", rInt),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_delete"},
+				ImportStateVerifyIgnore: []string{"force_delete", "ignore_additional_tag_names"},
 			},
 		},
 	})
@@ -1813,7 +2019,7 @@ func TestAccTFEWorkspace_importVCSBranch(t *testing.T) {
 				ResourceName:            "tfe_workspace.foobar",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_delete"},
+				ImportStateVerifyIgnore: []string{"force_delete", "ignore_additional_tag_names"},
 			},
 		},
 	})
@@ -1834,7 +2040,7 @@ func TestAccTFEWorkspace_importProject(t *testing.T) {
 				ResourceName:            "tfe_workspace.foobar",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_delete"},
+				ImportStateVerifyIgnore: []string{"force_delete", "ignore_additional_tag_names"},
 			},
 		},
 	})
@@ -2261,9 +2467,9 @@ func testAccCheckTFEWorkspacePanic(n string) resource.TestCheckFunc {
 		rd := &schema.ResourceData{}
 		rd.SetId(rs.Primary.ID)
 
-		err = resourceTFEWorkspaceRead(rd, testAccProvider.Meta())
-		if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
-			return fmt.Errorf("Could not re-read resource directly: %w", err)
+		diags := resourceTFEWorkspaceRead(ctx, rd, testAccProvider.Meta())
+		if diags.HasError() {
+			return fmt.Errorf("Could not re-read resource directly: %v", diags)
 		}
 
 		return nil
@@ -2811,6 +3017,21 @@ resource "tfe_workspace" "foobar" {
 }`, rInt)
 }
 
+func testAccTFEWorkspace_ignoreAdditionalTagNames(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name                        = "workspace-test"
+  organization                = tfe_organization.foobar.id
+  tag_names                   = ["prod"]
+  ignore_additional_tag_names = true
+}`, rInt)
+}
+
 func testAccTFEWorkspace_basicRemoveTagAlt(rInt int) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
@@ -3423,6 +3644,66 @@ func testAccTFEWorkspace_updateToTriggerPatternsFromTagsRegex(rInt int) string {
 	)
 }
 
+// testAccTFEWorkspace_vcsTriggersConfigurationGenerator builds a VCS backed
+// workspace. Empty trigger or tags regex values leave the argument out, and
+// the branch is only set when no tags regex is given.
+func testAccTFEWorkspace_vcsTriggersConfigurationGenerator(
+	rInt int,
+	fileTriggersEnabled bool,
+	triggerPrefixes string,
+	triggerPatterns string,
+	tagsRegex string,
+) string {
+	triggers := ""
+	if triggerPrefixes != "" {
+		triggers += fmt.Sprintf(`
+		trigger_prefixes      = %s`, triggerPrefixes)
+	}
+	if triggerPatterns != "" {
+		triggers += fmt.Sprintf(`
+		trigger_patterns      = %s`, triggerPatterns)
+	}
+
+	vcsRepoSource := fmt.Sprintf(`branch         = "%s"`, envGithubWorkspaceBranch)
+	if tagsRegex != "" {
+		vcsRepoSource = fmt.Sprintf(`tags_regex     = "%s"`, tagsRegex)
+	}
+
+	return fmt.Sprintf(`
+	resource "tfe_organization" "foobar" {
+		name  = "tst-tf-%d-git-triggers"
+		email = "admin@company.com"
+	}
+
+	resource "tfe_oauth_client" "test" {
+		organization     = tfe_organization.foobar.id
+		api_url          = "https://api.github.com"
+		http_url         = "https://github.com"
+		oauth_token      = "%s"
+		service_provider = "github"
+	}
+
+	resource "tfe_workspace" "foobar" {
+		name                  = "workspace-test"
+		organization          = tfe_organization.foobar.id
+		force_delete          = true
+		file_triggers_enabled = %t%s
+		vcs_repo {
+			identifier     = "%s"
+			oauth_token_id = tfe_oauth_client.test.oauth_token_id
+			%s
+		}
+	}
+	`,
+		rInt,
+		envGithubToken,
+		fileTriggersEnabled,
+		triggers,
+		envGithubWorkspaceIdentifier,
+		vcsRepoSource,
+	)
+}
+
 func testAccTFEWorkspace_updateRemoveVCSBlockFromTagsRegex(rInt int) string {
 	return fmt.Sprintf(`
 	resource "tfe_organization" "foobar" {
@@ -3566,6 +3847,36 @@ resource "tfe_workspace" "state_consumers" {
 }`, rInt)
 }
 
+// testAccTFEWorkspace_providerDefaultStructuredRunOutput leaves out the
+// provider default or the workspace setting when given an empty string.
+func testAccTFEWorkspace_providerDefaultStructuredRunOutput(rInt int, providerDefault, workspaceValue string) string {
+	providerConfig := ""
+	if providerDefault != "" {
+		providerConfig = fmt.Sprintf(`
+provider "tfe" {
+  default_structured_run_output_enabled = %s
+}
+`, providerDefault)
+	}
+
+	workspaceConfig := ""
+	if workspaceValue != "" {
+		workspaceConfig = fmt.Sprintf(`
+  structured_run_output_enabled = %s`, workspaceValue)
+	}
+
+	return fmt.Sprintf(`%s
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id%s
+}`, providerConfig, rInt, workspaceConfig)
+}
+
 func testAccTFEWorkspace_updateStructuredRunOutput(rInt int) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
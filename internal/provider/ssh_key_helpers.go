@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// fetchSSHKey returns the SSH key of an organization by name.
+func fetchSSHKey(orgName string, name string, client *tfe.Client) (*tfe.SSHKey, error) {
+	options := &tfe.SSHKeyListOptions{}
+
+	for {
+		l, err := client.SSHKeys.List(ctx, orgName, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving SSH keys: %w", err)
+		}
+
+		for _, k := range l.Items {
+			if k.Name == name {
+				return k, nil
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return nil, tfe.ErrResourceNotFound
+}
@@ -37,3 +37,29 @@ func fetchTeamByName(ctx context.Context, client *tfe.Client, orgName string, te
 	}
 	return nil, tfe.ErrResourceNotFound
 }
+
+func fetchTeamAccessByTeamID(ctx context.Context, client *tfe.Client, workspaceID string, teamID string) (*tfe.TeamAccess, error) {
+	listOptions := &tfe.TeamAccessListOptions{
+		WorkspaceID: workspaceID,
+	}
+
+	for {
+		tmAccessList, err := client.TeamAccess.List(ctx, listOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list team access: %w", err)
+		}
+
+		for _, tmAccess := range tmAccessList.Items {
+			if tmAccess.Team != nil && tmAccess.Team.ID == teamID {
+				return tmAccess, nil
+			}
+		}
+
+		if tmAccessList.CurrentPage >= tmAccessList.TotalPages {
+			break
+		}
+
+		listOptions.PageNumber = tmAccessList.NextPage
+	}
+	return nil, tfe.ErrResourceNotFound
+}
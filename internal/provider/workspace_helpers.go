@@ -6,9 +6,31 @@ package provider
 import (
 	"context"
 	"fmt"
+	"log"
+	"net/http"
 	"strings"
+	"time"
+	"unicode"
 
+	"github.com/hashicorp/go-cty/cty"
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+const (
+	// workspaceLockedRetryDefault is the default number of times a workspace
+	// update is retried while the workspace is locked.
+	workspaceLockedRetryDefault = 5
+	// workspaceLockedBackoffMin and workspaceLockedBackoffMax bound the wait
+	// between locked workspace update retries, in milliseconds.
+	workspaceLockedBackoffMin = 1000.0
+	workspaceLockedBackoffMax = 10000.0
+
+	// structuredRunOutputMinAPIVersion is the lowest remote API version, as
+	// reported in the TFP-API-Version response header, that accepts the
+	// structured-run-output-enabled workspace attribute.
+	structuredRunOutputMinAPIVersion = "2.5"
 )
 
 // fetchWorkspaceExternalID returns the external id for a workspace
@@ -106,3 +128,143 @@ func readWorkspaceStateConsumers(id string, client *tfe.Client) (bool, []interfa
 
 	return false, remoteStateConsumerIDs, nil
 }
+
+type workspaceUpdater interface {
+	UpdateByID(context.Context, string, tfe.WorkspaceUpdateOptions) (*tfe.Workspace, error)
+}
+
+// workspaceLockedStatus is the error go-tfe returns for an HTTP 423 Locked
+// response. go-tfe has no sentinel error for it and falls back to the HTTP
+// status line, such as "423 Locked".
+var workspaceLockedStatus = fmt.Sprintf("%d %s", http.StatusLocked, http.StatusText(http.StatusLocked))
+
+// isWorkspaceLockedError reports whether an error returned by the API means
+// that the workspace is locked (HTTP 423 Locked).
+func isWorkspaceLockedError(err error) bool {
+	return err != nil && err.Error() == workspaceLockedStatus
+}
+
+// workspaceLockedBackoff returns how long to wait before the given retry of a
+// locked workspace update.
+func workspaceLockedBackoff(attempt int) time.Duration {
+	return backoff(workspaceLockedBackoffMin, workspaceLockedBackoffMax, attempt)
+}
+
+// updateWorkspaceWithLockRetry updates a workspace, retrying up to maxRetries
+// times while the workspace is locked. wait returns the delay before each
+// retry.
+func updateWorkspaceWithLockRetry(ctx context.Context, u workspaceUpdater, id string, options tfe.WorkspaceUpdateOptions, maxRetries int, wait func(attempt int) time.Duration) (*tfe.Workspace, error) {
+	var err error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		// only perform exponential backoff during retries, not during initial attempt
+		if attempt > 0 {
+			log.Printf("[DEBUG] Workspace %s is locked, retrying update (%d/%d)", id, attempt, maxRetries)
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(wait(attempt)):
+			}
+		}
+
+		var ws *tfe.Workspace
+		ws, err = u.UpdateByID(ctx, id, options)
+		if !isWorkspaceLockedError(err) {
+			return ws, err
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"workspace %s is locked and could not be updated after %d retries. "+
+			"Wait for any active run to finish or unlock the workspace, then try again: %w", id, maxRetries, err)
+}
+
+// structuredRunOutputDroppedDiagnostics warns that structured_run_output_enabled,
+// whether configured on the workspace or taken from the provider's
+// default_structured_run_output_enabled, is not sent to a server that doesn't
+// support it.
+func structuredRunOutputDroppedDiagnostics(apiVersion string) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "structured_run_output_enabled is not supported",
+		Detail: fmt.Sprintf(
+			"The server reports API version %s, but structured_run_output_enabled requires API version %s or later. "+
+				"The value is not sent, and the server's setting is kept.", apiVersion, structuredRunOutputMinAPIVersion),
+		AttributePath: cty.GetAttrPath("structured_run_output_enabled"),
+	}}
+}
+
+// supportsStructuredRunOutput reports whether a server with the given remote
+// API version accepts the structured-run-output-enabled workspace attribute.
+// Only a server that reports an older version is known not to support it, so
+// an empty or unparsable version is treated as supported.
+func supportsStructuredRunOutput(apiVersion string) bool {
+	if apiVersion == "" {
+		return true
+	}
+
+	remote, err := version.NewVersion(apiVersion)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to parse remote API version %q: %v", apiVersion, err)
+		return true
+	}
+
+	return remote.GreaterThanOrEqual(version.Must(version.NewVersion(structuredRunOutputMinAPIVersion)))
+}
+
+// hasVCSConnection reports whether the VCS repository of a workspace is still
+// connected through an OAuth token or a GitHub App installation. Both are
+// empty once the OAuth client the workspace used has been deleted.
+func hasVCSConnection(vcsRepo *tfe.VCSRepo) bool {
+	return vcsRepo != nil && (vcsRepo.OAuthTokenID != "" || vcsRepo.GHAInstallationID != "")
+}
+
+// equalIgnoringTrailingWhitespace reports whether two strings only differ in
+// trailing whitespace, such as the trailing newlines the API trims from
+// multi-line workspace descriptions.
+func equalIgnoringTrailingWhitespace(a, b string) bool {
+	return strings.TrimRightFunc(a, unicode.IsSpace) == strings.TrimRightFunc(b, unicode.IsSpace)
+}
+
+// runNeedsAttention reports whether a run stopped in a failed state that
+// someone should look at, as opposed to applying, being discarded or canceled.
+func runNeedsAttention(status tfe.RunStatus) bool {
+	switch status {
+	case tfe.RunErrored, tfe.RunPolicySoftFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// managedTagNames returns the workspace tags that are also in managed, in the
+// order of tagNames.
+func managedTagNames(tagNames []string, managed []interface{}) []interface{} {
+	keep := make(map[string]bool, len(managed))
+	for _, name := range managed {
+		keep[name.(string)] = true
+	}
+
+	var tags []interface{}
+	for _, name := range tagNames {
+		if keep[name] {
+			tags = append(tags, name)
+		}
+	}
+	return tags
+}
+
+// validateTerraformVersion checks that a workspace terraform_version is
+// either an exact version, a version constraint like "~> 1.5" or "latest".
+// The API resolves constraints itself, so the value is sent as is. An empty
+// value leaves the version unset, as before.
+func validateTerraformVersion(v interface{}, k string) (warnings []string, errs []error) {
+	value := v.(string)
+	if value == "" || value == "latest" {
+		return
+	}
+
+	if _, err := version.NewConstraint(value); err != nil {
+		errs = append(errs, fmt.Errorf("%s must be an exact version or a version constraint, got %q: %w", k, value, err))
+	}
+	return
+}
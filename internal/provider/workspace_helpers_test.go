@@ -5,9 +5,14 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 func TestFetchWorkspaceExternalID(t *testing.T) {
@@ -230,3 +235,253 @@ func TestReadWorkspaceStateConsumers(t *testing.T) {
 		}
 	}
 }
+
+type mockWorkspaceUpdater struct {
+	lockedAttempts int
+	calls          int
+}
+
+func (m *mockWorkspaceUpdater) UpdateByID(ctx context.Context, id string, options tfe.WorkspaceUpdateOptions) (*tfe.Workspace, error) {
+	m.calls++
+	if m.calls <= m.lockedAttempts {
+		return nil, errors.New("423 Locked")
+	}
+
+	return &tfe.Workspace{ID: id}, nil
+}
+
+func TestIsWorkspaceLockedError(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want bool
+	}{
+		"nil":                  {err: nil, want: false},
+		"423 status":           {err: errors.New("423 Locked"), want: true},
+		"lock action conflict": {err: tfe.ErrWorkspaceLocked, want: false},
+		"other status":         {err: errors.New("409 Conflict"), want: false},
+		"mentions locked":      {err: errors.New("locked workspaces can't be deleted"), want: false},
+		"not found":            {err: tfe.ErrResourceNotFound, want: false},
+	}
+
+	for name, test := range tests {
+		if got := isWorkspaceLockedError(test.err); got != test.want {
+			t.Errorf("%s: isWorkspaceLockedError(%v) = %t, want %t", name, test.err, got, test.want)
+		}
+	}
+}
+
+func TestUpdateWorkspaceWithLockRetry(t *testing.T) {
+	tests := map[string]struct {
+		lockedAttempts int
+		maxRetries     int
+		wantCalls      int
+		err            bool
+	}{
+		"not locked": {
+			lockedAttempts: 0,
+			maxRetries:     2,
+			wantCalls:      1,
+			err:            false,
+		},
+		"unlocked before retries are exhausted": {
+			lockedAttempts: 1,
+			maxRetries:     2,
+			wantCalls:      2,
+			err:            false,
+		},
+		"locked after retries are exhausted": {
+			lockedAttempts: 3,
+			maxRetries:     1,
+			wantCalls:      2,
+			err:            true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			u := &mockWorkspaceUpdater{lockedAttempts: test.lockedAttempts}
+
+			noWait := func(int) time.Duration { return 0 }
+			ws, err := updateWorkspaceWithLockRetry(context.Background(), u, "ws-123", tfe.WorkspaceUpdateOptions{}, test.maxRetries, noWait)
+			if (err != nil) != test.err {
+				t.Fatalf("expected error is %t, got %v", test.err, err)
+			}
+			if err == nil && ws.ID != "ws-123" {
+				t.Fatalf("wrong workspace\ngot: %s\nwant: %s", ws.ID, "ws-123")
+			}
+			if u.calls != test.wantCalls {
+				t.Fatalf("wrong number of update calls\ngot: %d\nwant: %d", u.calls, test.wantCalls)
+			}
+		})
+	}
+}
+
+func TestSupportsStructuredRunOutput(t *testing.T) {
+	tests := map[string]bool{
+		"":        true,
+		"2.4":     false,
+		"2.5":     true,
+		"2.6.0":   true,
+		"3.0":     true,
+		"invalid": true,
+	}
+
+	for apiVersion, want := range tests {
+		if got := supportsStructuredRunOutput(apiVersion); got != want {
+			t.Errorf("supportsStructuredRunOutput(%q) = %t, want %t", apiVersion, got, want)
+		}
+	}
+}
+
+func TestStructuredRunOutputDroppedDiagnostics(t *testing.T) {
+	diags := structuredRunOutputDroppedDiagnostics("2.4")
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail, "2.4") {
+		t.Errorf("expected the warning to name the server's API version, got %q", diags[0].Detail)
+	}
+}
+
+func TestHasVCSConnection(t *testing.T) {
+	tests := map[string]struct {
+		vcsRepo *tfe.VCSRepo
+		want    bool
+	}{
+		"no VCS repository": {
+			vcsRepo: nil,
+			want:    false,
+		},
+		"OAuth token": {
+			vcsRepo: &tfe.VCSRepo{Identifier: "org/repo", OAuthTokenID: "ot-123"},
+			want:    true,
+		},
+		"GitHub App installation": {
+			vcsRepo: &tfe.VCSRepo{Identifier: "org/repo", GHAInstallationID: "ghain-123"},
+			want:    true,
+		},
+		"deleted connection": {
+			vcsRepo: &tfe.VCSRepo{Identifier: "org/repo"},
+			want:    false,
+		},
+	}
+
+	for name, test := range tests {
+		if got := hasVCSConnection(test.vcsRepo); got != test.want {
+			t.Errorf("%s: hasVCSConnection() = %t, want %t", name, got, test.want)
+		}
+	}
+}
+
+func TestEqualIgnoringTrailingWhitespace(t *testing.T) {
+	tests := map[string]struct {
+		a, b string
+		want bool
+	}{
+		"equal": {
+			a:    "line one\nline two",
+			b:    "line one\nline two",
+			want: true,
+		},
+		"trailing newline trimmed": {
+			a:    "line one\nline two",
+			b:    "line one\nline two\n",
+			want: true,
+		},
+		"trailing spaces and newlines trimmed": {
+			a:    "line one\nline two \n\n",
+			b:    "line one\nline two",
+			want: true,
+		},
+		"leading whitespace differs": {
+			a:    "  line one",
+			b:    "line one",
+			want: false,
+		},
+		"content differs": {
+			a:    "line one\nline two",
+			b:    "line one\nline 2",
+			want: false,
+		},
+	}
+
+	for name, test := range tests {
+		if got := equalIgnoringTrailingWhitespace(test.a, test.b); got != test.want {
+			t.Errorf("%s: equalIgnoringTrailingWhitespace(%q, %q) = %t, want %t", name, test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestRunNeedsAttention(t *testing.T) {
+	tests := map[tfe.RunStatus]bool{
+		tfe.RunErrored:            true,
+		tfe.RunPolicySoftFailed:   true,
+		tfe.RunApplied:            false,
+		tfe.RunPlannedAndFinished: false,
+		tfe.RunDiscarded:          false,
+		tfe.RunCanceled:           false,
+		tfe.RunPending:            false,
+	}
+
+	for status, want := range tests {
+		if got := runNeedsAttention(status); got != want {
+			t.Errorf("runNeedsAttention(%q) = %t, want %t", status, got, want)
+		}
+	}
+}
+
+func TestManagedTagNames(t *testing.T) {
+	tests := map[string]struct {
+		tagNames []string
+		managed  []interface{}
+		want     []interface{}
+	}{
+		"no tags": {
+			tagNames: nil,
+			managed:  []interface{}{"prod"},
+			want:     nil,
+		},
+		"no managed tags": {
+			tagNames: []string{"prod", "team-a"},
+			managed:  nil,
+			want:     nil,
+		},
+		"additional tags": {
+			tagNames: []string{"prod", "team-a", "app"},
+			managed:  []interface{}{"app", "prod"},
+			want:     []interface{}{"prod", "app"},
+		},
+		"managed tag removed remotely": {
+			tagNames: []string{"team-a"},
+			managed:  []interface{}{"prod"},
+			want:     nil,
+		},
+	}
+
+	for name, test := range tests {
+		if got := managedTagNames(test.tagNames, test.managed); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %v, want %v", name, got, test.want)
+		}
+	}
+}
+
+func TestValidateTerraformVersion(t *testing.T) {
+	tests := map[string]bool{
+		"1.5.7":               true,
+		"1.6.0-alpha20230719": true,
+		"~> 1.5":              true,
+		"~> 1.5.0":            true,
+		">= 1.4, < 1.6":       true,
+		"latest":              true,
+		"":                    true,
+		"one point five":      false,
+		"~>":                  false,
+	}
+
+	for value, valid := range tests {
+		_, errs := validateTerraformVersion(value, "terraform_version")
+		if got := len(errs) == 0; got != valid {
+			t.Errorf("validateTerraformVersion(%q) valid = %t, want %t: %v", value, got, valid, errs)
+		}
+	}
+}